@@ -90,6 +90,7 @@ func RootCommand() *cobra.Command {
 					rewindCommand,
 					command.NewRateCommand(logger, createTranscriptClient(grpcConn)),
 					command.NewShowRatingsCommand(logger, createTranscriptClient(grpcConn)),
+					command.NewTermFrequencyCommand(logger, createSearchClient(grpcConn)),
 				},
 				[]discord.Command{
 					command.NewArchiveCommand(logger, archive.NewStore(archiveDir)),
@@ -0,0 +1,75 @@
+package data
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/warmans/rsk-search/pkg/transcript"
+)
+
+// FormatCmd rewrites plaintext transcripts in the canonical format. If no files are given the transcript
+// is read from stdin and written to stdout.
+func FormatCmd() *cobra.Command {
+
+	var check bool
+
+	cmd := &cobra.Command{
+		Use:   "fmt [file...]",
+		Short: "rewrite plaintext transcripts in the canonical format",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			if len(args) == 0 {
+				raw, err := io.ReadAll(os.Stdin)
+				if err != nil {
+					return err
+				}
+				formatted, err := transcript.Format(string(raw))
+				if err != nil {
+					return err
+				}
+				if check {
+					if formatted != string(raw) {
+						return fmt.Errorf("input is not formatted")
+					}
+					return nil
+				}
+				_, err = fmt.Fprint(os.Stdout, formatted)
+				return err
+			}
+
+			unformatted := 0
+			for _, path := range args {
+				raw, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				formatted, err := transcript.Format(string(raw))
+				if err != nil {
+					return errors.Wrapf(err, "failed to format %s", path)
+				}
+				if formatted == string(raw) {
+					continue
+				}
+				if check {
+					fmt.Println(path)
+					unformatted++
+					continue
+				}
+				if err := os.WriteFile(path, []byte(formatted), 0666); err != nil {
+					return err
+				}
+			}
+			if unformatted > 0 {
+				return fmt.Errorf("%d files are not formatted", unformatted)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&check, "check", "c", false, "List files that are not formatted and exit with an error instead of rewriting them")
+
+	return cmd
+}
@@ -0,0 +1,91 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/warmans/rsk-search/pkg/lint"
+	"github.com/warmans/rsk-search/pkg/models"
+	"github.com/warmans/rsk-search/pkg/transcript"
+	"github.com/warmans/rsk-search/pkg/util"
+)
+
+var severityOrder = []lint.Severity{lint.SeverityInfo, lint.SeverityWarning, lint.SeverityError}
+
+// LintCmd checks the episode transcripts for common mistakes. Line numbers refer to the transcript as it
+// would appear in the editor.
+func LintCmd() *cobra.Command {
+
+	var minSeverity string
+	var failOn string
+
+	cmd := &cobra.Command{
+		Use:   "lint [episode-id...]",
+		Short: "check transcripts for problems such as unknown actors or invalid offsets",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			minIdx := slices.Index(severityOrder, lint.Severity(minSeverity))
+			if minIdx == -1 {
+				return fmt.Errorf("unknown severity: %s", minSeverity)
+			}
+			failIdx := slices.Index(severityOrder, lint.Severity(failOn))
+			if failOn != "" && failIdx == -1 {
+				return fmt.Errorf("unknown severity: %s", failOn)
+			}
+
+			dirEntries, err := os.ReadDir(cfg.dataDir)
+			if err != nil {
+				return err
+			}
+			failed := 0
+			for _, dirEntry := range dirEntries {
+				if dirEntry.IsDir() || !strings.HasSuffix(dirEntry.Name(), ".json") {
+					continue
+				}
+				if len(args) > 0 && !slices.Contains(args, strings.TrimSuffix(dirEntry.Name(), ".json")) {
+					continue
+				}
+
+				episode := &models.Transcript{}
+				if err := util.WithReadJSONFileDecoder(path.Join(cfg.dataDir, dirEntry.Name()), func(dec *json.Decoder) error {
+					return dec.Decode(episode)
+				}); err != nil {
+					return errors.Wrapf(err, "failed to read %s", dirEntry.Name())
+				}
+				raw, err := transcript.Export(episode.Transcript, episode.Synopsis, episode.Trivia)
+				if err != nil {
+					return errors.Wrapf(err, "failed to export %s", dirEntry.Name())
+				}
+				episodeFailed := false
+				for _, w := range lint.Lint(raw, lint.DefaultRules()...) {
+					idx := slices.Index(severityOrder, w.Severity)
+					if idx < minIdx {
+						continue
+					}
+					if failOn != "" && idx >= failIdx {
+						episodeFailed = true
+					}
+					fmt.Printf("%s:%s\n", episode.ID(), w.String())
+				}
+				if episodeFailed {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d episodes have problems with severity %s or higher", failed, failOn)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&minSeverity, "min-severity", "s", string(lint.SeverityWarning), "Only report problems of this severity or higher (info, warning, error)")
+	cmd.Flags().StringVarP(&failOn, "fail-on", "f", "", "Exit with an error if there are problems of this severity or higher")
+
+	return cmd
+}
@@ -1,20 +1,14 @@
 package data
 
 import (
-	"encoding/json"
 	"fmt"
 	"github.com/blugelabs/bluge"
-	"github.com/blugelabs/bluge/analysis"
-	"github.com/blugelabs/bluge/analysis/token"
-	"github.com/blugelabs/bluge/analysis/tokenizer"
 	"github.com/spf13/cobra"
-	"github.com/warmans/rsk-search/pkg/models"
-	"github.com/warmans/rsk-search/pkg/search"
-	"github.com/warmans/rsk-search/pkg/search/v2/mapping"
+	"github.com/warmans/rsk-search/pkg/data"
+	v2 "github.com/warmans/rsk-search/pkg/search/v2"
 	"go.uber.org/zap"
 	"os"
 	"path"
-	"time"
 )
 
 func PopulateBlugeIndex() *cobra.Command {
@@ -62,20 +56,14 @@ func populateIndex(inputDir string, writer *bluge.Writer, logger *zap.Logger) er
 			continue
 		}
 
-		docs, err := documentsFromPath(path.Join(inputDir, dirEntry.Name()))
+		episode, err := data.LoadEpisodePath(path.Join(inputDir, dirEntry.Name()))
 		if err != nil {
 			return err
 		}
 
 		batch := bluge.NewBatch()
-		for _, d := range docs {
-			doc := bluge.NewDocument(d.ID)
-			for k, t := range mapping.Mapping {
-				if mapped, ok := getMappedField(k, t, d); ok {
-					doc.AddField(mapped)
-				}
-			}
-			batch.Insert(doc)
+		for _, d := range v2.DocumentsFromTranscript(episode) {
+			batch.Insert(v2.BlugeDocument(d))
 		}
 		if err := writer.Batch(batch); err != nil {
 			return err
@@ -83,67 +71,3 @@ func populateIndex(inputDir string, writer *bluge.Writer, logger *zap.Logger) er
 	}
 	return nil
 }
-
-func getMappedField(fieldName string, t mapping.FieldType, d search.DialogDocument) (bluge.Field, bool) {
-	switch t {
-	case mapping.FieldTypeKeyword:
-		return bluge.NewKeywordField(fieldName, d.GetNamedField(fieldName).(string)).StoreValue().Aggregatable(), true
-	case mapping.FieldTypeDate:
-		dateField := d.GetNamedField(fieldName).(*time.Time)
-		if dateField == nil {
-			return nil, false
-		}
-		return bluge.NewDateTimeField(fieldName, *dateField).Aggregatable().Sortable(), true
-	case mapping.FieldTypeNumber:
-		return bluge.NewNumericField(fieldName, float64(d.GetNamedField(fieldName).(int64))).Sortable(), true
-	case mapping.FieldTypeShingles:
-		shingleAnalyzer := &analysis.Analyzer{
-			Tokenizer: tokenizer.NewUnicodeTokenizer(),
-			TokenFilters: []analysis.TokenFilter{
-				//token.NewLowerCaseFilter(),
-				token.NewNgramFilter(2, 16),
-			},
-		}
-		return bluge.NewTextField(fieldName, fmt.Sprintf("%v", d.GetNamedField(fieldName))).WithAnalyzer(shingleAnalyzer).SearchTermPositions().StoreValue(), true
-	}
-	// just use text for everything else
-	return bluge.NewTextField(fieldName, fmt.Sprintf("%v", d.GetNamedField(fieldName))).SearchTermPositions().StoreValue(), true
-}
-
-func documentsFromPath(filePath string) ([]search.DialogDocument, error) {
-
-	f, err := os.Open(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open file %s: %w", filePath, err)
-	}
-	defer func(f *os.File) {
-		_ = f.Close()
-	}(f)
-
-	episode := &models.Transcript{}
-
-	decoder := json.NewDecoder(f)
-	if err := decoder.Decode(episode); err != nil {
-		return nil, err
-	}
-
-	docs := []search.DialogDocument{}
-	for _, v := range episode.Transcript {
-		docs = append(docs, search.DialogDocument{
-			ID:           v.ID,
-			TranscriptID: episode.ID(),
-			Mapping:      "dialog",
-			Publication:  episode.Publication,
-			Series:       int64(episode.Series),
-			Episode:      int64(episode.Episode),
-			Date:         episode.ReleaseDate,
-			ContentType:  string(v.Type),
-			Actor:        v.Actor,
-			Position:     v.Position,
-			Content:      v.Content,
-			Special:      fmt.Sprintf("%v", episode.Special),
-		})
-	}
-
-	return docs, nil
-}
@@ -37,9 +37,13 @@ func RootCmd() *cobra.Command {
 	root.AddCommand(RefreshCmd())
 	root.AddCommand(DumpPlaintext())
 	root.AddCommand(DumpDialog())
+	root.AddCommand(LintCmd())
+	root.AddCommand(FormatCmd())
 
 	// index
 	root.AddCommand(PopulateBlugeIndex())
+	root.AddCommand(UpdateBlugeIndex())
+	root.AddCommand(SuggestAliasesCmd())
 
 	// assembly ai
 	root.AddCommand(TranscribeAssemblyAICmd())
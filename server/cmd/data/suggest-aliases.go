@@ -0,0 +1,63 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"github.com/blugelabs/bluge"
+	"github.com/spf13/cobra"
+	"github.com/warmans/rsk-search/pkg/meta"
+	v2 "github.com/warmans/rsk-search/pkg/search/v2"
+	"go.uber.org/zap"
+)
+
+// SuggestAliasesCmd lists terms used in similar contexts to the given terms as candidates for the alias dictionary
+// in pkg/meta/data/aliases.json.
+func SuggestAliasesCmd() *cobra.Command {
+
+	var indexPath string
+	var minCount int
+	var maxResults int
+
+	cmd := &cobra.Command{
+		Use:   "suggest-aliases [term...]",
+		Short: "suggest content aliases for the given terms based on the words they appear with in the search index",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			logger, _ := zap.NewProduction()
+			defer func() {
+				if err := logger.Sync(); err != nil {
+					fmt.Println("WARNING: failed to sync logger: " + err.Error())
+				}
+			}()
+
+			reader, err := bluge.OpenReader(bluge.DefaultConfig(indexPath))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := reader.Close(); err != nil {
+					logger.Error("failed to close index", zap.Error(err))
+				}
+			}()
+
+			for _, term := range args {
+				suggestions, err := v2.SuggestAliases(context.Background(), reader, term, minCount, maxResults)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("%s (existing aliases: %v nicknames: %v)\n", term, meta.Aliases("content", term), meta.Nicknames("content", term))
+				for _, s := range suggestions {
+					fmt.Printf("  %-20s similarity: %.3f co-occurrences: %d\n", s.Term, s.Similarity, s.CoOccurrences)
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&indexPath, "index-path", "i", "./var/gen/rsk.bluge", "Path to index file")
+	cmd.Flags().IntVarP(&minCount, "min-count", "", 10, "Ignore terms said fewer times than this")
+	cmd.Flags().IntVarP(&maxResults, "max-results", "", 20, "Number of suggestions per term")
+
+	return cmd
+}
@@ -0,0 +1,81 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"github.com/blugelabs/bluge"
+	"github.com/spf13/cobra"
+	"github.com/warmans/rsk-search/pkg/data"
+	v2 "github.com/warmans/rsk-search/pkg/search/v2"
+	"go.uber.org/zap"
+)
+
+// UpdateBlugeIndex re-indexes only the transcripts that have changed since they were last indexed.
+func UpdateBlugeIndex() *cobra.Command {
+
+	var indexPath string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "update-bluge-index",
+		Short: "re-index transcripts with a different version to the one in the search index",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			logger, _ := zap.NewProduction()
+			defer func() {
+				if err := logger.Sync(); err != nil {
+					fmt.Println("WARNING: failed to sync logger: " + err.Error())
+				}
+			}()
+
+			writer, err := bluge.OpenWriter(bluge.DefaultConfig(indexPath))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := writer.Close(); err != nil {
+					logger.Error("failed to close index", zap.Error(err))
+				}
+			}()
+
+			episodes, err := data.LoadAllEpisodes(cfg.dataDir)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			indexer := v2.NewIndexer(writer)
+
+			stale, removed, err := indexer.Stale(ctx, episodes)
+			if err != nil {
+				return err
+			}
+			logger.Info("Found stale transcripts", zap.Int("num_stale", len(stale)), zap.Int("num_removed", len(removed)))
+
+			for _, ep := range stale {
+				logger.Info("Indexing transcript...", zap.String("id", ep.ID()), zap.String("version", ep.Version))
+				if dryRun {
+					continue
+				}
+				if err := indexer.IndexTranscript(ctx, ep); err != nil {
+					return err
+				}
+			}
+			for _, id := range removed {
+				logger.Info("Removing transcript...", zap.String("id", id))
+				if dryRun {
+					continue
+				}
+				if err := indexer.RemoveTranscript(ctx, id); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&indexPath, "index-path", "i", "./var/gen/rsk.bluge", "Path to index file")
+	cmd.Flags().BoolVarP(&dryRun, "dry-run", "", false, "Only log the transcripts that would be updated")
+
+	return cmd
+}
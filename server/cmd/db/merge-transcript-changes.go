@@ -1,8 +1,6 @@
 package db
 
 import (
-	"bufio"
-	"bytes"
 	"context"
 	"fmt"
 	"os"
@@ -14,11 +12,11 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/warmans/rsk-search/pkg/data"
+	"github.com/warmans/rsk-search/pkg/diff"
 	"github.com/warmans/rsk-search/pkg/filter"
 	"github.com/warmans/rsk-search/pkg/models"
 	"github.com/warmans/rsk-search/pkg/store/common"
 	"github.com/warmans/rsk-search/pkg/store/rw"
-	"github.com/warmans/rsk-search/pkg/transcript"
 	"github.com/warmans/rsk-search/pkg/util"
 	"go.uber.org/zap"
 )
@@ -75,54 +73,70 @@ func mergeAll(outputDataPath string, migrationsPath string, conn *rw.Conn, dryRu
 	ctx := context.Background()
 
 	approvedChangeIDs := []string{}
+	// conflicting changes are returned to pending so the author can resolve them rather than being retried on every run.
+	conflictingChangeIDs := []string{}
 	err := conn.WithStore(func(s *rw.Store) error {
 
 		approvedChanges, err := s.ListTranscriptChanges(
 			ctx,
-			common.Q(common.WithFilter(
-				filter.And(
-					filter.Eq("state", filter.String(string(models.ContributionStateApproved))),
-					filter.Eq("merged", filter.Bool(false)),
-				)),
+			common.Q(
+				common.WithFilter(
+					filter.And(
+						filter.Eq("state", filter.String(string(models.ContributionStateApproved))),
+						filter.Eq("merged", filter.Bool(false)),
+					),
+				),
+				common.WithSorting("created_at", common.SortAsc),
 			),
 		)
 		if err != nil {
 			return err
 		}
 
+		// episodes that were already updated by an earlier change.
+		updatedEpisodes := map[string]*models.Transcript{}
+
 		for _, v := range approvedChanges {
 
 			logger.Info(fmt.Sprintf("Processing change %s (%s)...", v.ID, v.EpID))
 
-			episodeOnDisk, err := data.LoadEpisdeByEpisodeID(outputDataPath, v.EpID)
+			episodeOnDisk, ok := updatedEpisodes[v.EpID]
+			if !ok {
+				episodeOnDisk, err = data.LoadEpisdeByEpisodeID(outputDataPath, v.EpID)
+				if err != nil {
+					return err
+				}
+				if episodeOnDisk == nil {
+					panic("nil episode encountered: " + v.EpID)
+				}
+			}
+
+			ts, conflicts, err := diff.MergeChange(episodeOnDisk, v)
 			if err != nil {
 				return err
 			}
-			if episodeOnDisk == nil {
-				panic("nil episode encountered: " + v.EpID)
+			if len(conflicts) > 0 {
+				for _, c := range conflicts {
+					logger.Warn("Change conflicts with the current transcript", zap.String("change_id", v.ID), zap.String("epid", v.EpID), zap.String("conflict", c.String()))
+				}
+				logger.Warn("Returning conflicting change to pending", zap.String("change_id", v.ID), zap.String("epid", v.EpID))
+				if !dryRun {
+					conflictingChangeIDs = append(conflictingChangeIDs, v.ID)
+				}
+				continue
 			}
 
-			// clear old data
-			episodeOnDisk.Synopsis = nil
-			episodeOnDisk.Transcript = nil
-			episodeOnDisk.Trivia = nil
-
 			// contributors should be merged with whatever is on disk
 			uniqueContributors := map[string]struct{}{}
 			for _, v := range episodeOnDisk.Contributors {
 				uniqueContributors[v] = struct{}{}
 			}
 
-			ts, err := transcript.Import(bufio.NewScanner(bytes.NewBufferString(v.Transcription)), episodeOnDisk.ID(), 1)
-			if err != nil {
-				return err
-			}
-
 			// process contributors for this chunk of audio
 			uniqueContributors[v.Author.Name] = struct{}{}
-			episodeOnDisk.Transcript = append(episodeOnDisk.Transcript, ts.Transcript...)
-			episodeOnDisk.Synopsis = append(episodeOnDisk.Synopsis, ts.Synopsis...)
-			episodeOnDisk.Trivia = append(episodeOnDisk.Trivia, ts.Trivia...)
+			episodeOnDisk.Transcript = ts.Transcript
+			episodeOnDisk.Synopsis = ts.Synopsis
+			episodeOnDisk.Trivia = ts.Trivia
 
 			// metadata
 			if v.Summary != "" {
@@ -142,9 +156,9 @@ func mergeAll(outputDataPath string, migrationsPath string, conn *rw.Conn, dryRu
 			sort.Strings(contributors)
 			episodeOnDisk.Contributors = contributors
 
-			// update version
+			// update version. The version must always change so changes based on the old version can be detected.
 			switch true {
-			case v.PointsAwarded > 0 && v.PointsAwarded <= 1:
+			case v.PointsAwarded <= 1:
 				episodeOnDisk.Version, err = util.NextVersion(episodeOnDisk.Version, util.PatchVersion)
 			case v.PointsAwarded > 1 && v.PointsAwarded <= 2:
 				episodeOnDisk.Version, err = util.NextVersion(episodeOnDisk.Version, util.MinorVersion)
@@ -154,6 +168,7 @@ func mergeAll(outputDataPath string, migrationsPath string, conn *rw.Conn, dryRu
 			if err != nil {
 				return errors.Wrap(err, "failed to update version")
 			}
+			updatedEpisodes[v.EpID] = episodeOnDisk
 
 			if dryRun {
 				if err := stdoutPrinter.Encode(episodeOnDisk); err != nil {
@@ -172,24 +187,38 @@ func mergeAll(outputDataPath string, migrationsPath string, conn *rw.Conn, dryRu
 		return err
 	}
 
-	if len(approvedChangeIDs) > 0 {
-		return generateMigration(migrationsPath, approvedChangeIDs)
+	if len(approvedChangeIDs) > 0 || len(conflictingChangeIDs) > 0 {
+		return generateMigration(migrationsPath, approvedChangeIDs, conflictingChangeIDs)
 	}
 
 	return nil
 }
 
 // The change is only technically merged once it goes live so the merged flag must be set using a migration.
-func generateMigration(migrationsPath string, approvedChangeIDs []string) error {
-	for k, v := range approvedChangeIDs {
-		approvedChangeIDs[k] = fmt.Sprintf(`'%s'`, v)
+// Conflicting changes are returned to pending in the same migration.
+func generateMigration(migrationsPath string, approvedChangeIDs []string, conflictingChangeIDs []string) error {
+	statements := []string{}
+	if len(approvedChangeIDs) > 0 {
+		statements = append(statements, fmt.Sprintf("UPDATE transcript_change SET merged=true WHERE id IN (%s);", quoteIDs(approvedChangeIDs)))
+	}
+	if len(conflictingChangeIDs) > 0 {
+		statements = append(statements, fmt.Sprintf(
+			"UPDATE transcript_change SET state='%s' WHERE id IN (%s);",
+			models.ContributionStatePending,
+			quoteIDs(conflictingChangeIDs),
+		))
 	}
 	return os.WriteFile(
 		path.Join(migrationsPath, fmt.Sprintf("%d_merge_changes.sql", time.Now().Unix())),
-		[]byte(fmt.Sprintf(
-			"UPDATE transcript_change SET merged=true WHERE id IN (%s);",
-			strings.Join(approvedChangeIDs, ", "),
-		)),
+		[]byte(strings.Join(statements, "\n")),
 		0666,
 	)
 }
+
+func quoteIDs(ids []string) string {
+	quoted := make([]string, len(ids))
+	for k, v := range ids {
+		quoted[k] = fmt.Sprintf(`'%s'`, v)
+	}
+	return strings.Join(quoted, ", ")
+}
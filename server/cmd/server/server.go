@@ -15,8 +15,10 @@ import (
 	"github.com/warmans/rsk-search/pkg/mediacache"
 	"github.com/warmans/rsk-search/pkg/oauth"
 	"github.com/warmans/rsk-search/pkg/pledge"
+	"github.com/warmans/rsk-search/pkg/reload"
 	"github.com/warmans/rsk-search/pkg/reward"
 	"github.com/warmans/rsk-search/pkg/search"
+	"github.com/warmans/rsk-search/pkg/search/fts"
 	v2 "github.com/warmans/rsk-search/pkg/search/v2"
 	"github.com/warmans/rsk-search/pkg/sentry"
 	"github.com/warmans/rsk-search/pkg/server"
@@ -55,6 +57,7 @@ func ServerCmd() *cobra.Command {
 	assemblyAiCfg := &assemblyai.Config{}
 	sentryCfg := &sentry.Config{}
 	mediaCacheCfg := &mediacache.Config{}
+	reloadCfg := reload.Config{}
 
 	cmd := &cobra.Command{
 		Use:   "server",
@@ -94,7 +97,8 @@ func ServerCmd() *cobra.Command {
 				logger = sentry.LoggerWithSentry(logger, sentryClient, srvCfg.Env)
 			}
 
-			episodeCache, err := data.NewEpisodeStore(path.Join(srvCfg.FilesBasePath, "data", "episodes"))
+			episodesPath := path.Join(srvCfg.FilesBasePath, "data", "episodes")
+			episodeCache, err := data.NewEpisodeStore(episodesPath)
 			if err != nil {
 				logger.Fatal("failed to create episode cache", zap.Error(err))
 			}
@@ -112,13 +116,73 @@ func ServerCmd() *cobra.Command {
 			}()
 
 			// search index
+			var searcher search.Searcher
+			var generation search.GenerationFunc
+			var blugeSearcher *v2.Search
+			var ftsSearcher *fts.Search
 			blugeCfg := bluge.DefaultConfig(srvCfg.BlugeIndexPath)
-			rskIndex, err := bluge.OpenReader(blugeCfg)
-			if err != nil {
-				return err
+			switch srvCfg.SearchBackend {
+			case "bluge":
+				rskIndex, err := bluge.OpenReader(blugeCfg)
+				if err != nil {
+					return err
+				}
+				blugeSearcher = v2.NewSearch(rskIndex, episodeCache, srvCfg.AudioUriPattern, logger)
+				searcher, generation = blugeSearcher, blugeSearcher.IndexGeneration
+			case "sqlite":
+				logger.Info("Creating full text index...")
+				if err := fts.CreateIndex(context.Background(), readOnlyStoreConn); err != nil {
+					return err
+				}
+				ftsSearcher = fts.NewSearch(readOnlyStoreConn, episodeCache, logger)
+				searcher, generation = ftsSearcher, ftsSearcher.IndexGeneration
+			default:
+				return fmt.Errorf("unknown search backend: %s", srvCfg.SearchBackend)
 			}
-			searcher := v2.NewSearch(rskIndex, readOnlyStoreConn, episodeCache, srvCfg.AudioUriPattern, logger)
 			searcher = search.InstrumentSearcher(searcher, logger)
+			if srvCfg.SearchCacheSize > 0 {
+				searcher = search.CacheSearcher(searcher, srvCfg.SearchCacheSize, generation)
+			}
+
+			// static data can be replaced without a restart
+			reloader := reload.NewReloader(logger, reloadCfg, func(ctx context.Context) error {
+				// build everything before swapping anything to minimise the time the data is inconsistent.
+				newEpisodeCache, err := data.NewEpisodeStore(episodesPath)
+				if err != nil {
+					return fmt.Errorf("failed to create episode cache: %w", err)
+				}
+				newReadOnlyStoreConn, err := ro.NewConn(roDbCfg)
+				if err != nil {
+					return fmt.Errorf("failed to open RO db: %w", err)
+				}
+				var newIndex *bluge.Reader
+				if blugeSearcher != nil {
+					if newIndex, err = bluge.OpenReader(blugeCfg); err != nil {
+						_ = newReadOnlyStoreConn.Close()
+						return fmt.Errorf("failed to open index: %w", err)
+					}
+				} else {
+					if err := fts.CreateIndex(ctx, newReadOnlyStoreConn); err != nil {
+						_ = newReadOnlyStoreConn.Close()
+						return fmt.Errorf("failed to create full text index: %w", err)
+					}
+				}
+				episodeCache.Swap(newEpisodeCache)
+				if err := readOnlyStoreConn.Swap(newReadOnlyStoreConn.Conn); err != nil {
+					logger.Error("failed to close previous RO db", zap.Error(err))
+				}
+				if blugeSearcher == nil {
+					ftsSearcher.IndexSwapped()
+					return nil
+				}
+				return blugeSearcher.SwapIndex(newIndex)
+			})
+			go func() {
+				if err := reloader.Start(); err != nil {
+					logger.Fatal("reloader failed", zap.Error(err))
+				}
+			}()
+			defer reloader.Stop()
 
 			// DB is persistent and will retain data between deployments
 			logger.Info("Init persistent DB...")
@@ -246,6 +310,7 @@ func ServerCmd() *cobra.Command {
 					taskQueue,
 					auth,
 					persistentDBConn,
+					reloader,
 				),
 				grpc.NewStatusService(
 					logger,
@@ -329,6 +394,7 @@ func ServerCmd() *cobra.Command {
 	assemblyAiCfg.RegisterFlags(cmd.Flags(), ServicePrefix)
 	sentryCfg.RegisterFlags(cmd.Flags(), ServicePrefix)
 	mediaCacheCfg.RegisterFlags(cmd.Flags(), ServicePrefix)
+	reloadCfg.RegisterFlags(cmd.Flags(), ServicePrefix)
 
 	return cmd
 
@@ -580,14 +580,18 @@ const file_admin_proto_rawDesc = "" +
 	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"A\n" +
 	"\x11TscriptImportList\x12,\n" +
-	"\aimports\x18\x01 \x03(\v2\x12.rsk.TscriptImportR\aimports2\x9c\x05\n" +
+	"\aimports\x18\x01 \x03(\v2\x12.rsk.TscriptImportR\aimports2\xf0\x06\n" +
 	"\fAdminService\x12\x8d\x02\n" +
 	"\rDeleteTscript\x12\x19.rsk.DeleteTscriptRequest\x1a\x16.google.protobuf.Empty\"\xc8\x01\x92A\xa5\x01\n" +
 	"\x06search\x12\x8b\x01Delete a completed partial transacript. This should only be done after the data has been extracted to the permanent transcript (flat file).*\rdeleteTscript\x82\xd3\xe4\x93\x02\x19*\x17/api/admin/tscript/{id}\x12\xca\x01\n" +
 	"\x13CreateTscriptImport\x12\x1f.rsk.CreateTscriptImportRequest\x1a\x12.rsk.TscriptImport\"~\x92AW\n" +
 	"\x06search\x128Creates a new incomplete transcript by importing an mp3.*\x13createTscriptImport\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/admin/tscript/import\x12\xae\x01\n" +
 	"\x12ListTscriptImports\x12\x1e.rsk.ListTscriptImportsRequest\x1a\x16.rsk.TscriptImportList\"`\x92A;\n" +
-	"\x06search\x12\x1dLists previously run imports.*\x12listTscriptImports\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/admin/tscript/importsBf\x92A5\x12\x052\x031.0*\x01\x01r)\n" +
+	"\x06search\x12\x1dLists previously run imports.*\x12listTscriptImports\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/admin/tscript/imports\x12\xd1\x01\n" +
+	"\n" +
+	"ReloadData\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x92\x01\x92Av\n" +
+	"\x06search\x12`Reload the search index, read-only DB and episode cache from disk without restarting the server.*\n" +
+	"reloadData\x82\xd3\xe4\x93\x02\x13\"\x11/api/admin/reloadBf\x92A5\x12\x052\x031.0*\x01\x01r)\n" +
 	"\x10Admin functions.\x12\x15https://scrimpton.comZ,github.com/warmans/rsk-search/server/gen/apib\x06proto3"
 
 var file_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
@@ -606,11 +610,13 @@ var file_admin_proto_depIdxs = []int32{
 	0, // 2: rsk.AdminService.DeleteTscript:input_type -> rsk.DeleteTscriptRequest
 	1, // 3: rsk.AdminService.CreateTscriptImport:input_type -> rsk.CreateTscriptImportRequest
 	4, // 4: rsk.AdminService.ListTscriptImports:input_type -> rsk.ListTscriptImportsRequest
-	6, // 5: rsk.AdminService.DeleteTscript:output_type -> google.protobuf.Empty
-	2, // 6: rsk.AdminService.CreateTscriptImport:output_type -> rsk.TscriptImport
-	5, // 7: rsk.AdminService.ListTscriptImports:output_type -> rsk.TscriptImportList
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
+	6, // 5: rsk.AdminService.ReloadData:input_type -> google.protobuf.Empty
+	6, // 6: rsk.AdminService.DeleteTscript:output_type -> google.protobuf.Empty
+	2, // 7: rsk.AdminService.CreateTscriptImport:output_type -> rsk.TscriptImport
+	5, // 8: rsk.AdminService.ListTscriptImports:output_type -> rsk.TscriptImportList
+	6, // 9: rsk.AdminService.ReloadData:output_type -> google.protobuf.Empty
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
 	2, // [2:2] is the sub-list for extension type_name
 	2, // [2:2] is the sub-list for extension extendee
 	0, // [0:2] is the sub-list for field type_name
@@ -23,6 +23,7 @@ const (
 	AdminService_DeleteTscript_FullMethodName       = "/rsk.AdminService/DeleteTscript"
 	AdminService_CreateTscriptImport_FullMethodName = "/rsk.AdminService/CreateTscriptImport"
 	AdminService_ListTscriptImports_FullMethodName  = "/rsk.AdminService/ListTscriptImports"
+	AdminService_ReloadData_FullMethodName          = "/rsk.AdminService/ReloadData"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -32,6 +33,7 @@ type AdminServiceClient interface {
 	DeleteTscript(ctx context.Context, in *DeleteTscriptRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CreateTscriptImport(ctx context.Context, in *CreateTscriptImportRequest, opts ...grpc.CallOption) (*TscriptImport, error)
 	ListTscriptImports(ctx context.Context, in *ListTscriptImportsRequest, opts ...grpc.CallOption) (*TscriptImportList, error)
+	ReloadData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type adminServiceClient struct {
@@ -72,6 +74,16 @@ func (c *adminServiceClient) ListTscriptImports(ctx context.Context, in *ListTsc
 	return out, nil
 }
 
+func (c *adminServiceClient) ReloadData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminService_ReloadData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations should embed UnimplementedAdminServiceServer
 // for forward compatibility.
@@ -79,6 +91,7 @@ type AdminServiceServer interface {
 	DeleteTscript(context.Context, *DeleteTscriptRequest) (*emptypb.Empty, error)
 	CreateTscriptImport(context.Context, *CreateTscriptImportRequest) (*TscriptImport, error)
 	ListTscriptImports(context.Context, *ListTscriptImportsRequest) (*TscriptImportList, error)
+	ReloadData(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 }
 
 // UnimplementedAdminServiceServer should be embedded to have
@@ -97,6 +110,9 @@ func (UnimplementedAdminServiceServer) CreateTscriptImport(context.Context, *Cre
 func (UnimplementedAdminServiceServer) ListTscriptImports(context.Context, *ListTscriptImportsRequest) (*TscriptImportList, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListTscriptImports not implemented")
 }
+func (UnimplementedAdminServiceServer) ReloadData(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReloadData not implemented")
+}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -171,6 +187,24 @@ func _AdminService_ListTscriptImports_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ReloadData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReloadData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReloadData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReloadData(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -190,6 +224,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTscriptImports",
 			Handler:    _AdminService_ListTscriptImports_Handler,
 		},
+		{
+			MethodName: "ReloadData",
+			Handler:    _AdminService_ReloadData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin.proto",
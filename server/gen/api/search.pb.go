@@ -25,6 +25,53 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ListPhrasesRequest_Scoring int32
+
+const (
+	// LOG_LIKELIHOOD favours phrases that are both common and said together more often than chance.
+	ListPhrasesRequest_LOG_LIKELIHOOD ListPhrasesRequest_Scoring = 0
+	// PMI (pointwise mutual information) favours phrases whose words are rarely said apart.
+	ListPhrasesRequest_PMI ListPhrasesRequest_Scoring = 1
+	// COUNT is the number of times the phrase was said.
+	ListPhrasesRequest_COUNT ListPhrasesRequest_Scoring = 2
+)
+
+// Enum value maps for ListPhrasesRequest_Scoring.
+var (
+	ListPhrasesRequest_Scoring_name = map[int32]string{
+		0: "LOG_LIKELIHOOD",
+		1: "PMI",
+		2: "COUNT",
+	}
+	ListPhrasesRequest_Scoring_value = map[string]int32{
+		"LOG_LIKELIHOOD": 0,
+		"PMI":            1,
+		"COUNT":          2,
+	}
+)
+
+func (x ListPhrasesRequest_Scoring) Enum() *ListPhrasesRequest_Scoring {
+	p := new(ListPhrasesRequest_Scoring)
+	*p = x
+	return p
+}
+
+func (x ListPhrasesRequest_Scoring) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListPhrasesRequest_Scoring) Descriptor() protoreflect.EnumDescriptor {
+	return file_search_proto_enumTypes[0].Descriptor()
+}
+
+func (ListPhrasesRequest_Scoring) Type() protoreflect.EnumType {
+	return &file_search_proto_enumTypes[0]
+}
+
+func (x ListPhrasesRequest_Scoring) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 type FieldMeta_Kind int32
 
 const (
@@ -73,11 +120,11 @@ func (x FieldMeta_Kind) String() string {
 }
 
 func (FieldMeta_Kind) Descriptor() protoreflect.EnumDescriptor {
-	return file_search_proto_enumTypes[0].Descriptor()
+	return file_search_proto_enumTypes[1].Descriptor()
 }
 
 func (FieldMeta_Kind) Type() protoreflect.EnumType {
-	return &file_search_proto_enumTypes[0]
+	return &file_search_proto_enumTypes[1]
 }
 
 func (x FieldMeta_Kind) Number() protoreflect.EnumNumber {
@@ -85,12 +132,32 @@ func (x FieldMeta_Kind) Number() protoreflect.EnumNumber {
 }
 
 type SearchRequest struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Sort          string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page  int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Sort  string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	// sequence searches for exchanges of lines rather than single lines. Each element is a filter that must match
+	// a line following the line matched by the previous element. The query is applied to all elements.
+	Sequence []string `protobuf:"bytes,4,rep,name=sequence,proto3" json:"sequence,omitempty"`
+	// sequence_window is the max number of lines between each element of the sequence.
+	SequenceWindow int32 `protobuf:"varint,5,opt,name=sequence_window,json=sequenceWindow,proto3" json:"sequence_window,omitempty"`
+	// facets to count for the matched lines e.g. publication, series, year, type, special.
+	Facets []string `protobuf:"bytes,6,rep,name=facets,proto3" json:"facets,omitempty"`
+	// page_size defaults to 10 if not set.
+	PageSize int32 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// search_after is an opaque cursor taken from a previous result list's next_search_after. If set the
+	// page is ignored and the results will continue from the end of the previous page.
+	SearchAfter string `protobuf:"bytes,8,opt,name=search_after,json=searchAfter,proto3" json:"search_after,omitempty"`
+	// explain includes the parsed filter, generated query and per-result score explanations in the response. It is
+	// only available to approvers unless enabled for all users by the server.
+	Explain bool `protobuf:"varint,9,opt,name=explain,proto3" json:"explain,omitempty"`
+	// context_lines is the number of lines before and after each matched line to include. Defaults to 3 if not set.
+	ContextLines int32 `protobuf:"varint,10,opt,name=context_lines,json=contextLines,proto3" json:"context_lines,omitempty"`
+	// disable_aliases only matches the exact terms given. By default content and actor terms also match their
+	// aliases e.g. "telly" will also match "tv".
+	DisableAliases bool `protobuf:"varint,11,opt,name=disable_aliases,json=disableAliases,proto3" json:"disable_aliases,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SearchRequest) Reset() {
@@ -132,67 +199,1157 @@ func (x *SearchRequest) GetPage() int32 {
 	return 0
 }
 
-func (x *SearchRequest) GetSort() string {
+func (x *SearchRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetSequence() []string {
+	if x != nil {
+		return x.Sequence
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetSequenceWindow() int32 {
+	if x != nil {
+		return x.SequenceWindow
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetFacets() []string {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetSearchAfter() string {
+	if x != nil {
+		return x.SearchAfter
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetExplain() bool {
+	if x != nil {
+		return x.Explain
+	}
+	return false
+}
+
+func (x *SearchRequest) GetContextLines() int32 {
+	if x != nil {
+		return x.ContextLines
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetDisableAliases() bool {
+	if x != nil {
+		return x.DisableAliases
+	}
+	return false
+}
+
+func (x *SearchRequest) SetQuery(v string) {
+	x.Query = v
+}
+
+func (x *SearchRequest) SetPage(v int32) {
+	x.Page = v
+}
+
+func (x *SearchRequest) SetSort(v string) {
+	x.Sort = v
+}
+
+func (x *SearchRequest) SetSequence(v []string) {
+	x.Sequence = v
+}
+
+func (x *SearchRequest) SetSequenceWindow(v int32) {
+	x.SequenceWindow = v
+}
+
+func (x *SearchRequest) SetFacets(v []string) {
+	x.Facets = v
+}
+
+func (x *SearchRequest) SetPageSize(v int32) {
+	x.PageSize = v
+}
+
+func (x *SearchRequest) SetSearchAfter(v string) {
+	x.SearchAfter = v
+}
+
+func (x *SearchRequest) SetExplain(v bool) {
+	x.Explain = v
+}
+
+func (x *SearchRequest) SetContextLines(v int32) {
+	x.ContextLines = v
+}
+
+func (x *SearchRequest) SetDisableAliases(v bool) {
+	x.DisableAliases = v
+}
+
+type SearchRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Query string
+	Page  int32
+	Sort  string
+	// sequence searches for exchanges of lines rather than single lines. Each element is a filter that must match
+	// a line following the line matched by the previous element. The query is applied to all elements.
+	Sequence []string
+	// sequence_window is the max number of lines between each element of the sequence.
+	SequenceWindow int32
+	// facets to count for the matched lines e.g. publication, series, year, type, special.
+	Facets []string
+	// page_size defaults to 10 if not set.
+	PageSize int32
+	// search_after is an opaque cursor taken from a previous result list's next_search_after. If set the
+	// page is ignored and the results will continue from the end of the previous page.
+	SearchAfter string
+	// explain includes the parsed filter, generated query and per-result score explanations in the response. It is
+	// only available to approvers unless enabled for all users by the server.
+	Explain bool
+	// context_lines is the number of lines before and after each matched line to include. Defaults to 3 if not set.
+	ContextLines int32
+	// disable_aliases only matches the exact terms given. By default content and actor terms also match their
+	// aliases e.g. "telly" will also match "tv".
+	DisableAliases bool
+}
+
+func (b0 SearchRequest_builder) Build() *SearchRequest {
+	m0 := &SearchRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Query = b.Query
+	x.Page = b.Page
+	x.Sort = b.Sort
+	x.Sequence = b.Sequence
+	x.SequenceWindow = b.SequenceWindow
+	x.Facets = b.Facets
+	x.PageSize = b.PageSize
+	x.SearchAfter = b.SearchAfter
+	x.Explain = b.Explain
+	x.ContextLines = b.ContextLines
+	x.DisableAliases = b.DisableAliases
+	return m0
+}
+
+type GetTermFrequencyRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// terms are words or phrases to compare. Each term is counted separately.
+	Terms []string `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	// by_actor splits each term into a series per actor.
+	ByActor       bool `protobuf:"varint,2,opt,name=by_actor,json=byActor,proto3" json:"by_actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTermFrequencyRequest) Reset() {
+	*x = GetTermFrequencyRequest{}
+	mi := &file_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTermFrequencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTermFrequencyRequest) ProtoMessage() {}
+
+func (x *GetTermFrequencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetTermFrequencyRequest) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *GetTermFrequencyRequest) GetByActor() bool {
+	if x != nil {
+		return x.ByActor
+	}
+	return false
+}
+
+func (x *GetTermFrequencyRequest) SetTerms(v []string) {
+	x.Terms = v
+}
+
+func (x *GetTermFrequencyRequest) SetByActor(v bool) {
+	x.ByActor = v
+}
+
+type GetTermFrequencyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// terms are words or phrases to compare. Each term is counted separately.
+	Terms []string
+	// by_actor splits each term into a series per actor.
+	ByActor bool
+}
+
+func (b0 GetTermFrequencyRequest_builder) Build() *GetTermFrequencyRequest {
+	m0 := &GetTermFrequencyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Terms = b.Terms
+	x.ByActor = b.ByActor
+	return m0
+}
+
+type TermFrequency struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// episode_ids label the values of each series in release order.
+	EpisodeIds    []string               `protobuf:"bytes,1,rep,name=episode_ids,json=episodeIds,proto3" json:"episode_ids,omitempty"`
+	Series        []*TermFrequencySeries `protobuf:"bytes,2,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TermFrequency) Reset() {
+	*x = TermFrequency{}
+	mi := &file_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TermFrequency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermFrequency) ProtoMessage() {}
+
+func (x *TermFrequency) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TermFrequency) GetEpisodeIds() []string {
+	if x != nil {
+		return x.EpisodeIds
+	}
+	return nil
+}
+
+func (x *TermFrequency) GetSeries() []*TermFrequencySeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *TermFrequency) SetEpisodeIds(v []string) {
+	x.EpisodeIds = v
+}
+
+func (x *TermFrequency) SetSeries(v []*TermFrequencySeries) {
+	x.Series = v
+}
+
+type TermFrequency_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// episode_ids label the values of each series in release order.
+	EpisodeIds []string
+	Series     []*TermFrequencySeries
+}
+
+func (b0 TermFrequency_builder) Build() *TermFrequency {
+	m0 := &TermFrequency{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.EpisodeIds = b.EpisodeIds
+	x.Series = b.Series
+	return m0
+}
+
+type TermFrequencySeries struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	Term  string                 `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	// actor is only set if the request was by_actor.
+	Actor string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// counts are the number of times the term was said in each episode.
+	Counts []int32 `protobuf:"varint,3,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+	// frequencies are the counts per 10,000 words said in each episode (or by the actor in each episode).
+	Frequencies   []float32 `protobuf:"fixed32,4,rep,packed,name=frequencies,proto3" json:"frequencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TermFrequencySeries) Reset() {
+	*x = TermFrequencySeries{}
+	mi := &file_search_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TermFrequencySeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermFrequencySeries) ProtoMessage() {}
+
+func (x *TermFrequencySeries) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TermFrequencySeries) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *TermFrequencySeries) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *TermFrequencySeries) GetCounts() []int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *TermFrequencySeries) GetFrequencies() []float32 {
+	if x != nil {
+		return x.Frequencies
+	}
+	return nil
+}
+
+func (x *TermFrequencySeries) SetTerm(v string) {
+	x.Term = v
+}
+
+func (x *TermFrequencySeries) SetActor(v string) {
+	x.Actor = v
+}
+
+func (x *TermFrequencySeries) SetCounts(v []int32) {
+	x.Counts = v
+}
+
+func (x *TermFrequencySeries) SetFrequencies(v []float32) {
+	x.Frequencies = v
+}
+
+type TermFrequencySeries_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Term string
+	// actor is only set if the request was by_actor.
+	Actor string
+	// counts are the number of times the term was said in each episode.
+	Counts []int32
+	// frequencies are the counts per 10,000 words said in each episode (or by the actor in each episode).
+	Frequencies []float32
+}
+
+func (b0 TermFrequencySeries_builder) Build() *TermFrequencySeries {
+	m0 := &TermFrequencySeries{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Term = b.Term
+	x.Actor = b.Actor
+	x.Counts = b.Counts
+	x.Frequencies = b.Frequencies
+	return m0
+}
+
+type ListPhrasesRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// min_words defaults to 2.
+	MinWords int32 `protobuf:"varint,1,opt,name=min_words,json=minWords,proto3" json:"min_words,omitempty"`
+	// max_words defaults to 5.
+	MaxWords int32 `protobuf:"varint,2,opt,name=max_words,json=maxWords,proto3" json:"max_words,omitempty"`
+	// actor limits the phrases to lines said by the given actor.
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// publication limits the phrases to the given publication e.g. xfm.
+	Publication string `protobuf:"bytes,4,opt,name=publication,proto3" json:"publication,omitempty"`
+	// series limits the phrases to the given series. It requires the publication to be set.
+	Series int32 `protobuf:"varint,5,opt,name=series,proto3" json:"series,omitempty"`
+	// episode_id limits the phrases to a single episode e.g. xfm-S1E01.
+	EpisodeId string                     `protobuf:"bytes,6,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	Scoring   ListPhrasesRequest_Scoring `protobuf:"varint,7,opt,name=scoring,proto3,enum=rsk.ListPhrasesRequest_Scoring" json:"scoring,omitempty"`
+	// min_count excludes phrases said fewer times. Defaults to 5 and must be at least 3 unless episode_id is set.
+	MinCount int32 `protobuf:"varint,8,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	// max_results defaults to 50.
+	MaxResults    int32 `protobuf:"varint,9,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPhrasesRequest) Reset() {
+	*x = ListPhrasesRequest{}
+	mi := &file_search_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPhrasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPhrasesRequest) ProtoMessage() {}
+
+func (x *ListPhrasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListPhrasesRequest) GetMinWords() int32 {
+	if x != nil {
+		return x.MinWords
+	}
+	return 0
+}
+
+func (x *ListPhrasesRequest) GetMaxWords() int32 {
+	if x != nil {
+		return x.MaxWords
+	}
+	return 0
+}
+
+func (x *ListPhrasesRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListPhrasesRequest) GetPublication() string {
+	if x != nil {
+		return x.Publication
+	}
+	return ""
+}
+
+func (x *ListPhrasesRequest) GetSeries() int32 {
+	if x != nil {
+		return x.Series
+	}
+	return 0
+}
+
+func (x *ListPhrasesRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *ListPhrasesRequest) GetScoring() ListPhrasesRequest_Scoring {
+	if x != nil {
+		return x.Scoring
+	}
+	return ListPhrasesRequest_LOG_LIKELIHOOD
+}
+
+func (x *ListPhrasesRequest) GetMinCount() int32 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+func (x *ListPhrasesRequest) GetMaxResults() int32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *ListPhrasesRequest) SetMinWords(v int32) {
+	x.MinWords = v
+}
+
+func (x *ListPhrasesRequest) SetMaxWords(v int32) {
+	x.MaxWords = v
+}
+
+func (x *ListPhrasesRequest) SetActor(v string) {
+	x.Actor = v
+}
+
+func (x *ListPhrasesRequest) SetPublication(v string) {
+	x.Publication = v
+}
+
+func (x *ListPhrasesRequest) SetSeries(v int32) {
+	x.Series = v
+}
+
+func (x *ListPhrasesRequest) SetEpisodeId(v string) {
+	x.EpisodeId = v
+}
+
+func (x *ListPhrasesRequest) SetScoring(v ListPhrasesRequest_Scoring) {
+	x.Scoring = v
+}
+
+func (x *ListPhrasesRequest) SetMinCount(v int32) {
+	x.MinCount = v
+}
+
+func (x *ListPhrasesRequest) SetMaxResults(v int32) {
+	x.MaxResults = v
+}
+
+type ListPhrasesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// min_words defaults to 2.
+	MinWords int32
+	// max_words defaults to 5.
+	MaxWords int32
+	// actor limits the phrases to lines said by the given actor.
+	Actor string
+	// publication limits the phrases to the given publication e.g. xfm.
+	Publication string
+	// series limits the phrases to the given series. It requires the publication to be set.
+	Series int32
+	// episode_id limits the phrases to a single episode e.g. xfm-S1E01.
+	EpisodeId string
+	Scoring   ListPhrasesRequest_Scoring
+	// min_count excludes phrases said fewer times. Defaults to 5 and must be at least 3 unless episode_id is set.
+	MinCount int32
+	// max_results defaults to 50.
+	MaxResults int32
+}
+
+func (b0 ListPhrasesRequest_builder) Build() *ListPhrasesRequest {
+	m0 := &ListPhrasesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.MinWords = b.MinWords
+	x.MaxWords = b.MaxWords
+	x.Actor = b.Actor
+	x.Publication = b.Publication
+	x.Series = b.Series
+	x.EpisodeId = b.EpisodeId
+	x.Scoring = b.Scoring
+	x.MinCount = b.MinCount
+	x.MaxResults = b.MaxResults
+	return m0
+}
+
+type PhraseList struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Phrases       []*Phrase              `protobuf:"bytes,1,rep,name=phrases,proto3" json:"phrases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PhraseList) Reset() {
+	*x = PhraseList{}
+	mi := &file_search_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PhraseList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhraseList) ProtoMessage() {}
+
+func (x *PhraseList) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PhraseList) GetPhrases() []*Phrase {
+	if x != nil {
+		return x.Phrases
+	}
+	return nil
+}
+
+func (x *PhraseList) SetPhrases(v []*Phrase) {
+	x.Phrases = v
+}
+
+type PhraseList_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Phrases []*Phrase
+}
+
+func (b0 PhraseList_builder) Build() *PhraseList {
+	m0 := &PhraseList{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Phrases = b.Phrases
+	return m0
+}
+
+type Phrase struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Phrase        string                 `protobuf:"bytes,1,opt,name=phrase,proto3" json:"phrase,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Score         float32                `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Phrase) Reset() {
+	*x = Phrase{}
+	mi := &file_search_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Phrase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Phrase) ProtoMessage() {}
+
+func (x *Phrase) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Phrase) GetPhrase() string {
+	if x != nil {
+		return x.Phrase
+	}
+	return ""
+}
+
+func (x *Phrase) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Phrase) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Phrase) SetPhrase(v string) {
+	x.Phrase = v
+}
+
+func (x *Phrase) SetCount(v int32) {
+	x.Count = v
+}
+
+func (x *Phrase) SetScore(v float32) {
+	x.Score = v
+}
+
+type Phrase_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Phrase string
+	Count  int32
+	Score  float32
+}
+
+func (b0 Phrase_builder) Build() *Phrase {
+	m0 := &Phrase{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Phrase = b.Phrase
+	x.Count = b.Count
+	x.Score = b.Score
+	return m0
+}
+
+type SearchSimilarRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// dialog_id is the ID of the source line e.g. ep-xfm-S1E06-347
+	DialogId string `protobuf:"bytes,1,opt,name=dialog_id,json=dialogId,proto3" json:"dialog_id,omitempty"`
+	// max_results defaults to 10 if not set.
+	MaxResults    int32 `protobuf:"varint,2,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSimilarRequest) Reset() {
+	*x = SearchSimilarRequest{}
+	mi := &file_search_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSimilarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSimilarRequest) ProtoMessage() {}
+
+func (x *SearchSimilarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchSimilarRequest) GetDialogId() string {
+	if x != nil {
+		return x.DialogId
+	}
+	return ""
+}
+
+func (x *SearchSimilarRequest) GetMaxResults() int32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *SearchSimilarRequest) SetDialogId(v string) {
+	x.DialogId = v
+}
+
+func (x *SearchSimilarRequest) SetMaxResults(v int32) {
+	x.MaxResults = v
+}
+
+type SearchSimilarRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// dialog_id is the ID of the source line e.g. ep-xfm-S1E06-347
+	DialogId string
+	// max_results defaults to 10 if not set.
+	MaxResults int32
+}
+
+func (b0 SearchSimilarRequest_builder) Build() *SearchSimilarRequest {
+	m0 := &SearchSimilarRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.DialogId = b.DialogId
+	x.MaxResults = b.MaxResults
+	return m0
+}
+
+type SearchResultList struct {
+	state       protoimpl.MessageState  `protogen:"hybrid.v1"`
+	Results     []*SearchResult         `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	ResultCount int32                   `protobuf:"varint,2,opt,name=result_count,json=resultCount,proto3" json:"result_count,omitempty"`
+	Stats       map[string]*SearchStats `protobuf:"bytes,3,rep,name=stats,proto3" json:"stats,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Facets      []*Facet                `protobuf:"bytes,4,rep,name=facets,proto3" json:"facets,omitempty"`
+	// next_search_after is a cursor that can be used to fetch the next page of results. It will be empty
+	// if there are no more results.
+	NextSearchAfter string `protobuf:"bytes,5,opt,name=next_search_after,json=nextSearchAfter,proto3" json:"next_search_after,omitempty"`
+	// suggestions are corrected queries that may be used if the query returned no results.
+	Suggestions []string `protobuf:"bytes,6,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	// explanation is only set if the request was made with explain enabled.
+	Explanation   *SearchExplanation `protobuf:"bytes,7,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResultList) Reset() {
+	*x = SearchResultList{}
+	mi := &file_search_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResultList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResultList) ProtoMessage() {}
+
+func (x *SearchResultList) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchResultList) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchResultList) GetResultCount() int32 {
+	if x != nil {
+		return x.ResultCount
+	}
+	return 0
+}
+
+func (x *SearchResultList) GetStats() map[string]*SearchStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *SearchResultList) GetFacets() []*Facet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *SearchResultList) GetNextSearchAfter() string {
+	if x != nil {
+		return x.NextSearchAfter
+	}
+	return ""
+}
+
+func (x *SearchResultList) GetSuggestions() []string {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *SearchResultList) GetExplanation() *SearchExplanation {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
+func (x *SearchResultList) SetResults(v []*SearchResult) {
+	x.Results = v
+}
+
+func (x *SearchResultList) SetResultCount(v int32) {
+	x.ResultCount = v
+}
+
+func (x *SearchResultList) SetStats(v map[string]*SearchStats) {
+	x.Stats = v
+}
+
+func (x *SearchResultList) SetFacets(v []*Facet) {
+	x.Facets = v
+}
+
+func (x *SearchResultList) SetNextSearchAfter(v string) {
+	x.NextSearchAfter = v
+}
+
+func (x *SearchResultList) SetSuggestions(v []string) {
+	x.Suggestions = v
+}
+
+func (x *SearchResultList) SetExplanation(v *SearchExplanation) {
+	x.Explanation = v
+}
+
+func (x *SearchResultList) HasExplanation() bool {
+	if x == nil {
+		return false
+	}
+	return x.Explanation != nil
+}
+
+func (x *SearchResultList) ClearExplanation() {
+	x.Explanation = nil
+}
+
+type SearchResultList_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Results     []*SearchResult
+	ResultCount int32
+	Stats       map[string]*SearchStats
+	Facets      []*Facet
+	// next_search_after is a cursor that can be used to fetch the next page of results. It will be empty
+	// if there are no more results.
+	NextSearchAfter string
+	// suggestions are corrected queries that may be used if the query returned no results.
+	Suggestions []string
+	// explanation is only set if the request was made with explain enabled.
+	Explanation *SearchExplanation
+}
+
+func (b0 SearchResultList_builder) Build() *SearchResultList {
+	m0 := &SearchResultList{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Results = b.Results
+	x.ResultCount = b.ResultCount
+	x.Stats = b.Stats
+	x.Facets = b.Facets
+	x.NextSearchAfter = b.NextSearchAfter
+	x.Suggestions = b.Suggestions
+	x.Explanation = b.Explanation
+	return m0
+}
+
+type SearchExplanation struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// filter is the parsed query.
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// query is the index query generated from the filter.
+	Query         string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchExplanation) Reset() {
+	*x = SearchExplanation{}
+	mi := &file_search_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchExplanation) ProtoMessage() {}
+
+func (x *SearchExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchExplanation) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *SearchExplanation) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchExplanation) SetFilter(v string) {
+	x.Filter = v
+}
+
+func (x *SearchExplanation) SetQuery(v string) {
+	x.Query = v
+}
+
+type SearchExplanation_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// filter is the parsed query.
+	Filter string
+	// query is the index query generated from the filter.
+	Query string
+}
+
+func (b0 SearchExplanation_builder) Build() *SearchExplanation {
+	m0 := &SearchExplanation{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Filter = b.Filter
+	x.Query = b.Query
+	return m0
+}
+
+type ScoreExplanation struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Value         float32                `protobuf:"fixed32,1,opt,name=value,proto3" json:"value,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Children      []*ScoreExplanation    `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreExplanation) Reset() {
+	*x = ScoreExplanation{}
+	mi := &file_search_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreExplanation) ProtoMessage() {}
+
+func (x *ScoreExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ScoreExplanation) GetValue() float32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ScoreExplanation) GetMessage() string {
 	if x != nil {
-		return x.Sort
+		return x.Message
 	}
 	return ""
 }
 
-func (x *SearchRequest) SetQuery(v string) {
-	x.Query = v
+func (x *ScoreExplanation) GetChildren() []*ScoreExplanation {
+	if x != nil {
+		return x.Children
+	}
+	return nil
 }
 
-func (x *SearchRequest) SetPage(v int32) {
-	x.Page = v
+func (x *ScoreExplanation) SetValue(v float32) {
+	x.Value = v
 }
 
-func (x *SearchRequest) SetSort(v string) {
-	x.Sort = v
+func (x *ScoreExplanation) SetMessage(v string) {
+	x.Message = v
 }
 
-type SearchRequest_builder struct {
+func (x *ScoreExplanation) SetChildren(v []*ScoreExplanation) {
+	x.Children = v
+}
+
+type ScoreExplanation_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Query string
-	Page  int32
-	Sort  string
+	Value    float32
+	Message  string
+	Children []*ScoreExplanation
 }
 
-func (b0 SearchRequest_builder) Build() *SearchRequest {
-	m0 := &SearchRequest{}
+func (b0 ScoreExplanation_builder) Build() *ScoreExplanation {
+	m0 := &ScoreExplanation{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.Query = b.Query
-	x.Page = b.Page
-	x.Sort = b.Sort
+	x.Value = b.Value
+	x.Message = b.Message
+	x.Children = b.Children
 	return m0
 }
 
-type SearchResultList struct {
-	state         protoimpl.MessageState  `protogen:"hybrid.v1"`
-	Results       []*SearchResult         `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	ResultCount   int32                   `protobuf:"varint,2,opt,name=result_count,json=resultCount,proto3" json:"result_count,omitempty"`
-	Stats         map[string]*SearchStats `protobuf:"bytes,3,rep,name=stats,proto3" json:"stats,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type Facet struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []*FieldValue          `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchResultList) Reset() {
-	*x = SearchResultList{}
-	mi := &file_search_proto_msgTypes[1]
+func (x *Facet) Reset() {
+	*x = Facet{}
+	mi := &file_search_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchResultList) String() string {
+func (x *Facet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchResultList) ProtoMessage() {}
+func (*Facet) ProtoMessage() {}
 
-func (x *SearchResultList) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[1]
+func (x *Facet) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -203,68 +1360,59 @@ func (x *SearchResultList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *SearchResultList) GetResults() []*SearchResult {
-	if x != nil {
-		return x.Results
-	}
-	return nil
-}
-
-func (x *SearchResultList) GetResultCount() int32 {
+func (x *Facet) GetName() string {
 	if x != nil {
-		return x.ResultCount
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchResultList) GetStats() map[string]*SearchStats {
+func (x *Facet) GetValues() []*FieldValue {
 	if x != nil {
-		return x.Stats
+		return x.Values
 	}
 	return nil
 }
 
-func (x *SearchResultList) SetResults(v []*SearchResult) {
-	x.Results = v
-}
-
-func (x *SearchResultList) SetResultCount(v int32) {
-	x.ResultCount = v
+func (x *Facet) SetName(v string) {
+	x.Name = v
 }
 
-func (x *SearchResultList) SetStats(v map[string]*SearchStats) {
-	x.Stats = v
+func (x *Facet) SetValues(v []*FieldValue) {
+	x.Values = v
 }
 
-type SearchResultList_builder struct {
+type Facet_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Results     []*SearchResult
-	ResultCount int32
-	Stats       map[string]*SearchStats
+	Name   string
+	Values []*FieldValue
 }
 
-func (b0 SearchResultList_builder) Build() *SearchResultList {
-	m0 := &SearchResultList{}
+func (b0 Facet_builder) Build() *Facet {
+	m0 := &Facet{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.Results = b.Results
-	x.ResultCount = b.ResultCount
-	x.Stats = b.Stats
+	x.Name = b.Name
+	x.Values = b.Values
 	return m0
 }
 
 type SearchResult struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
-	Episode       *ShortTranscript       `protobuf:"bytes,1,opt,name=episode,proto3" json:"episode,omitempty"`
-	Dialogs       []*DialogResult        `protobuf:"bytes,2,rep,name=dialogs,proto3" json:"dialogs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"hybrid.v1"`
+	Episode *ShortTranscript       `protobuf:"bytes,1,opt,name=episode,proto3" json:"episode,omitempty"`
+	Dialogs []*DialogResult        `protobuf:"bytes,2,rep,name=dialogs,proto3" json:"dialogs,omitempty"`
+	// entity is set instead of dialogs if the result was a synopsis, trivia, tag or song rather than a line of dialog.
+	Entity *EntityResult `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
+	// score_explanation is only set if the request was made with explain enabled.
+	ScoreExplanation *ScoreExplanation `protobuf:"bytes,4,opt,name=score_explanation,json=scoreExplanation,proto3" json:"score_explanation,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *SearchResult) Reset() {
 	*x = SearchResult{}
-	mi := &file_search_proto_msgTypes[2]
+	mi := &file_search_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -276,7 +1424,7 @@ func (x *SearchResult) String() string {
 func (*SearchResult) ProtoMessage() {}
 
 func (x *SearchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[2]
+	mi := &file_search_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,6 +1449,20 @@ func (x *SearchResult) GetDialogs() []*DialogResult {
 	return nil
 }
 
+func (x *SearchResult) GetEntity() *EntityResult {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *SearchResult) GetScoreExplanation() *ScoreExplanation {
+	if x != nil {
+		return x.ScoreExplanation
+	}
+	return nil
+}
+
 func (x *SearchResult) SetEpisode(v *ShortTranscript) {
 	x.Episode = v
 }
@@ -309,6 +1471,14 @@ func (x *SearchResult) SetDialogs(v []*DialogResult) {
 	x.Dialogs = v
 }
 
+func (x *SearchResult) SetEntity(v *EntityResult) {
+	x.Entity = v
+}
+
+func (x *SearchResult) SetScoreExplanation(v *ScoreExplanation) {
+	x.ScoreExplanation = v
+}
+
 func (x *SearchResult) HasEpisode() bool {
 	if x == nil {
 		return false
@@ -316,15 +1486,41 @@ func (x *SearchResult) HasEpisode() bool {
 	return x.Episode != nil
 }
 
+func (x *SearchResult) HasEntity() bool {
+	if x == nil {
+		return false
+	}
+	return x.Entity != nil
+}
+
+func (x *SearchResult) HasScoreExplanation() bool {
+	if x == nil {
+		return false
+	}
+	return x.ScoreExplanation != nil
+}
+
 func (x *SearchResult) ClearEpisode() {
 	x.Episode = nil
 }
 
+func (x *SearchResult) ClearEntity() {
+	x.Entity = nil
+}
+
+func (x *SearchResult) ClearScoreExplanation() {
+	x.ScoreExplanation = nil
+}
+
 type SearchResult_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	Episode *ShortTranscript
 	Dialogs []*DialogResult
+	// entity is set instead of dialogs if the result was a synopsis, trivia, tag or song rather than a line of dialog.
+	Entity *EntityResult
+	// score_explanation is only set if the request was made with explain enabled.
+	ScoreExplanation *ScoreExplanation
 }
 
 func (b0 SearchResult_builder) Build() *SearchResult {
@@ -333,6 +1529,8 @@ func (b0 SearchResult_builder) Build() *SearchResult {
 	_, _ = b, x
 	x.Episode = b.Episode
 	x.Dialogs = b.Dialogs
+	x.Entity = b.Entity
+	x.ScoreExplanation = b.ScoreExplanation
 	return m0
 }
 
@@ -346,7 +1544,7 @@ type SearchStats struct {
 
 func (x *SearchStats) Reset() {
 	*x = SearchStats{}
-	mi := &file_search_proto_msgTypes[3]
+	mi := &file_search_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -358,7 +1556,7 @@ func (x *SearchStats) String() string {
 func (*SearchStats) ProtoMessage() {}
 
 func (x *SearchStats) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[3]
+	mi := &file_search_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +1615,7 @@ type DialogResult struct {
 
 func (x *DialogResult) Reset() {
 	*x = DialogResult{}
-	mi := &file_search_proto_msgTypes[4]
+	mi := &file_search_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -429,7 +1627,7 @@ func (x *DialogResult) String() string {
 func (*DialogResult) ProtoMessage() {}
 
 func (x *DialogResult) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[4]
+	mi := &file_search_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,6 +1676,123 @@ func (b0 DialogResult_builder) Build() *DialogResult {
 	return m0
 }
 
+type EntityResult struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// doc_type is one of synopsis, trivia, tag or song.
+	DocType string `protobuf:"bytes,1,opt,name=doc_type,json=docType,proto3" json:"doc_type,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// start_pos and end_pos are the range of dialog positions the entity refers to.
+	StartPos      int32   `protobuf:"varint,3,opt,name=start_pos,json=startPos,proto3" json:"start_pos,omitempty"`
+	EndPos        int32   `protobuf:"varint,4,opt,name=end_pos,json=endPos,proto3" json:"end_pos,omitempty"`
+	Score         float32 `protobuf:"fixed32,5,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityResult) Reset() {
+	*x = EntityResult{}
+	mi := &file_search_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityResult) ProtoMessage() {}
+
+func (x *EntityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *EntityResult) GetDocType() string {
+	if x != nil {
+		return x.DocType
+	}
+	return ""
+}
+
+func (x *EntityResult) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *EntityResult) GetStartPos() int32 {
+	if x != nil {
+		return x.StartPos
+	}
+	return 0
+}
+
+func (x *EntityResult) GetEndPos() int32 {
+	if x != nil {
+		return x.EndPos
+	}
+	return 0
+}
+
+func (x *EntityResult) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *EntityResult) SetDocType(v string) {
+	x.DocType = v
+}
+
+func (x *EntityResult) SetContent(v string) {
+	x.Content = v
+}
+
+func (x *EntityResult) SetStartPos(v int32) {
+	x.StartPos = v
+}
+
+func (x *EntityResult) SetEndPos(v int32) {
+	x.EndPos = v
+}
+
+func (x *EntityResult) SetScore(v float32) {
+	x.Score = v
+}
+
+type EntityResult_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// doc_type is one of synopsis, trivia, tag or song.
+	DocType string
+	Content string
+	// start_pos and end_pos are the range of dialog positions the entity refers to.
+	StartPos int32
+	EndPos   int32
+	Score    float32
+}
+
+func (b0 EntityResult_builder) Build() *EntityResult {
+	m0 := &EntityResult{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.DocType = b.DocType
+	x.Content = b.Content
+	x.StartPos = b.StartPos
+	x.EndPos = b.EndPos
+	x.Score = b.Score
+	return m0
+}
+
 type Metadata struct {
 	state           protoimpl.MessageState `protogen:"hybrid.v1"`
 	SearchFields    []*FieldMeta           `protobuf:"bytes,1,rep,name=search_fields,json=searchFields,proto3" json:"search_fields,omitempty"`
@@ -488,7 +1803,7 @@ type Metadata struct {
 
 func (x *Metadata) Reset() {
 	*x = Metadata{}
-	mi := &file_search_proto_msgTypes[5]
+	mi := &file_search_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -500,7 +1815,7 @@ func (x *Metadata) String() string {
 func (*Metadata) ProtoMessage() {}
 
 func (x *Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[5]
+	mi := &file_search_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,7 +1874,7 @@ type FieldMeta struct {
 
 func (x *FieldMeta) Reset() {
 	*x = FieldMeta{}
-	mi := &file_search_proto_msgTypes[6]
+	mi := &file_search_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -571,7 +1886,7 @@ func (x *FieldMeta) String() string {
 func (*FieldMeta) ProtoMessage() {}
 
 func (x *FieldMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[6]
+	mi := &file_search_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,7 +1945,7 @@ type ListFieldValuesRequest struct {
 
 func (x *ListFieldValuesRequest) Reset() {
 	*x = ListFieldValuesRequest{}
-	mi := &file_search_proto_msgTypes[7]
+	mi := &file_search_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -642,7 +1957,7 @@ func (x *ListFieldValuesRequest) String() string {
 func (*ListFieldValuesRequest) ProtoMessage() {}
 
 func (x *ListFieldValuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[7]
+	mi := &file_search_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,7 +2015,7 @@ type FieldValueList struct {
 
 func (x *FieldValueList) Reset() {
 	*x = FieldValueList{}
-	mi := &file_search_proto_msgTypes[8]
+	mi := &file_search_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -712,7 +2027,7 @@ func (x *FieldValueList) String() string {
 func (*FieldValueList) ProtoMessage() {}
 
 func (x *FieldValueList) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[8]
+	mi := &file_search_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,7 +2073,7 @@ type FieldValue struct {
 
 func (x *FieldValue) Reset() {
 	*x = FieldValue{}
-	mi := &file_search_proto_msgTypes[9]
+	mi := &file_search_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -770,7 +2085,7 @@ func (x *FieldValue) String() string {
 func (*FieldValue) ProtoMessage() {}
 
 func (x *FieldValue) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[9]
+	mi := &file_search_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -831,7 +2146,7 @@ type PredictSearchTermRequest struct {
 
 func (x *PredictSearchTermRequest) Reset() {
 	*x = PredictSearchTermRequest{}
-	mi := &file_search_proto_msgTypes[10]
+	mi := &file_search_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -843,7 +2158,7 @@ func (x *PredictSearchTermRequest) String() string {
 func (*PredictSearchTermRequest) ProtoMessage() {}
 
 func (x *PredictSearchTermRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[10]
+	mi := &file_search_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,7 +2243,7 @@ type SearchTermPredictions struct {
 
 func (x *SearchTermPredictions) Reset() {
 	*x = SearchTermPredictions{}
-	mi := &file_search_proto_msgTypes[11]
+	mi := &file_search_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -940,7 +2255,7 @@ func (x *SearchTermPredictions) String() string {
 func (*SearchTermPredictions) ProtoMessage() {}
 
 func (x *SearchTermPredictions) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[11]
+	mi := &file_search_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,7 +2317,7 @@ type Prediction struct {
 
 func (x *Prediction) Reset() {
 	*x = Prediction{}
-	mi := &file_search_proto_msgTypes[12]
+	mi := &file_search_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1014,7 +2329,7 @@ func (x *Prediction) String() string {
 func (*Prediction) ProtoMessage() {}
 
 func (x *Prediction) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[12]
+	mi := &file_search_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1113,7 +2428,7 @@ type WordPosition struct {
 
 func (x *WordPosition) Reset() {
 	*x = WordPosition{}
-	mi := &file_search_proto_msgTypes[13]
+	mi := &file_search_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1125,7 +2440,7 @@ func (x *WordPosition) String() string {
 func (*WordPosition) ProtoMessage() {}
 
 func (x *WordPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[13]
+	mi := &file_search_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1200,7 +2515,7 @@ type ListChangelogsRequest struct {
 
 func (x *ListChangelogsRequest) Reset() {
 	*x = ListChangelogsRequest{}
-	mi := &file_search_proto_msgTypes[14]
+	mi := &file_search_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1212,7 +2527,7 @@ func (x *ListChangelogsRequest) String() string {
 func (*ListChangelogsRequest) ProtoMessage() {}
 
 func (x *ListChangelogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[14]
+	mi := &file_search_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1309,7 +2624,7 @@ type ChangelogList struct {
 
 func (x *ChangelogList) Reset() {
 	*x = ChangelogList{}
-	mi := &file_search_proto_msgTypes[15]
+	mi := &file_search_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1321,7 +2636,7 @@ func (x *ChangelogList) String() string {
 func (*ChangelogList) ProtoMessage() {}
 
 func (x *ChangelogList) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[15]
+	mi := &file_search_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1367,7 +2682,7 @@ type Changelog struct {
 
 func (x *Changelog) Reset() {
 	*x = Changelog{}
-	mi := &file_search_proto_msgTypes[16]
+	mi := &file_search_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1379,7 +2694,7 @@ func (x *Changelog) String() string {
 func (*Changelog) ProtoMessage() {}
 
 func (x *Changelog) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[16]
+	mi := &file_search_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1436,7 +2751,7 @@ type GetRandomQuoteRequest struct {
 
 func (x *GetRandomQuoteRequest) Reset() {
 	*x = GetRandomQuoteRequest{}
-	mi := &file_search_proto_msgTypes[17]
+	mi := &file_search_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1448,7 +2763,7 @@ func (x *GetRandomQuoteRequest) String() string {
 func (*GetRandomQuoteRequest) ProtoMessage() {}
 
 func (x *GetRandomQuoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[17]
+	mi := &file_search_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1484,7 +2799,7 @@ type RandomQuote struct {
 
 func (x *RandomQuote) Reset() {
 	*x = RandomQuote{}
-	mi := &file_search_proto_msgTypes[18]
+	mi := &file_search_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1496,7 +2811,7 @@ func (x *RandomQuote) String() string {
 func (*RandomQuote) ProtoMessage() {}
 
 func (x *RandomQuote) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[18]
+	mi := &file_search_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1597,7 +2912,7 @@ type ListSongsRequest struct {
 
 func (x *ListSongsRequest) Reset() {
 	*x = ListSongsRequest{}
-	mi := &file_search_proto_msgTypes[19]
+	mi := &file_search_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1609,7 +2924,7 @@ func (x *ListSongsRequest) String() string {
 func (*ListSongsRequest) ProtoMessage() {}
 
 func (x *ListSongsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[19]
+	mi := &file_search_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1707,7 +3022,7 @@ type SongList struct {
 
 func (x *SongList) Reset() {
 	*x = SongList{}
-	mi := &file_search_proto_msgTypes[20]
+	mi := &file_search_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1719,7 +3034,7 @@ func (x *SongList) String() string {
 func (*SongList) ProtoMessage() {}
 
 func (x *SongList) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[20]
+	mi := &file_search_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1783,7 +3098,7 @@ type Song struct {
 
 func (x *Song) Reset() {
 	*x = Song{}
-	mi := &file_search_proto_msgTypes[21]
+	mi := &file_search_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1795,7 +3110,7 @@ func (x *Song) String() string {
 func (*Song) ProtoMessage() {}
 
 func (x *Song) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[21]
+	mi := &file_search_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1917,7 +3232,7 @@ type GetRoadmapRequest struct {
 
 func (x *GetRoadmapRequest) Reset() {
 	*x = GetRoadmapRequest{}
-	mi := &file_search_proto_msgTypes[22]
+	mi := &file_search_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1929,7 +3244,7 @@ func (x *GetRoadmapRequest) String() string {
 func (*GetRoadmapRequest) ProtoMessage() {}
 
 func (x *GetRoadmapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[22]
+	mi := &file_search_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1961,7 +3276,7 @@ type Roadmap struct {
 
 func (x *Roadmap) Reset() {
 	*x = Roadmap{}
-	mi := &file_search_proto_msgTypes[23]
+	mi := &file_search_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1973,7 +3288,7 @@ func (x *Roadmap) String() string {
 func (*Roadmap) ProtoMessage() {}
 
 func (x *Roadmap) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[23]
+	mi := &file_search_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2013,22 +3328,87 @@ var File_search_proto protoreflect.FileDescriptor
 
 const file_search_proto_rawDesc = "" +
 	"\n" +
-	"\fsearch.proto\x12\x03rsk\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x10transcript.proto\"M\n" +
+	"\fsearch.proto\x12\x03rsk\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x10transcript.proto\"\xd2\x02\n" +
 	"\rSearchRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
-	"\x04sort\x18\x03 \x01(\tR\x04sort\"\xe6\x01\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x1a\n" +
+	"\bsequence\x18\x04 \x03(\tR\bsequence\x12'\n" +
+	"\x0fsequence_window\x18\x05 \x01(\x05R\x0esequenceWindow\x12\x16\n" +
+	"\x06facets\x18\x06 \x03(\tR\x06facets\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12!\n" +
+	"\fsearch_after\x18\b \x01(\tR\vsearchAfter\x12\x18\n" +
+	"\aexplain\x18\t \x01(\bR\aexplain\x12#\n" +
+	"\rcontext_lines\x18\n" +
+	" \x01(\x05R\fcontextLines\x12'\n" +
+	"\x0fdisable_aliases\x18\v \x01(\bR\x0edisableAliases\"J\n" +
+	"\x17GetTermFrequencyRequest\x12\x14\n" +
+	"\x05terms\x18\x01 \x03(\tR\x05terms\x12\x19\n" +
+	"\bby_actor\x18\x02 \x01(\bR\abyActor\"b\n" +
+	"\rTermFrequency\x12\x1f\n" +
+	"\vepisode_ids\x18\x01 \x03(\tR\n" +
+	"episodeIds\x120\n" +
+	"\x06series\x18\x02 \x03(\v2\x18.rsk.TermFrequencySeriesR\x06series\"y\n" +
+	"\x13TermFrequencySeries\x12\x12\n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06counts\x18\x03 \x03(\x05R\x06counts\x12 \n" +
+	"\vfrequencies\x18\x04 \x03(\x02R\vfrequencies\"\xe9\x02\n" +
+	"\x12ListPhrasesRequest\x12\x1b\n" +
+	"\tmin_words\x18\x01 \x01(\x05R\bminWords\x12\x1b\n" +
+	"\tmax_words\x18\x02 \x01(\x05R\bmaxWords\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12 \n" +
+	"\vpublication\x18\x04 \x01(\tR\vpublication\x12\x16\n" +
+	"\x06series\x18\x05 \x01(\x05R\x06series\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x06 \x01(\tR\tepisodeId\x129\n" +
+	"\ascoring\x18\a \x01(\x0e2\x1f.rsk.ListPhrasesRequest.ScoringR\ascoring\x12\x1b\n" +
+	"\tmin_count\x18\b \x01(\x05R\bminCount\x12\x1f\n" +
+	"\vmax_results\x18\t \x01(\x05R\n" +
+	"maxResults\"1\n" +
+	"\aScoring\x12\x12\n" +
+	"\x0eLOG_LIKELIHOOD\x10\x00\x12\a\n" +
+	"\x03PMI\x10\x01\x12\t\n" +
+	"\x05COUNT\x10\x02\"3\n" +
+	"\n" +
+	"PhraseList\x12%\n" +
+	"\aphrases\x18\x01 \x03(\v2\v.rsk.PhraseR\aphrases\"L\n" +
+	"\x06Phrase\x12\x16\n" +
+	"\x06phrase\x18\x01 \x01(\tR\x06phrase\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x02R\x05score\"T\n" +
+	"\x14SearchSimilarRequest\x12\x1b\n" +
+	"\tdialog_id\x18\x01 \x01(\tR\bdialogId\x12\x1f\n" +
+	"\vmax_results\x18\x02 \x01(\x05R\n" +
+	"maxResults\"\x92\x03\n" +
 	"\x10SearchResultList\x12+\n" +
 	"\aresults\x18\x01 \x03(\v2\x11.rsk.SearchResultR\aresults\x12!\n" +
 	"\fresult_count\x18\x02 \x01(\x05R\vresultCount\x126\n" +
-	"\x05stats\x18\x03 \x03(\v2 .rsk.SearchResultList.StatsEntryR\x05stats\x1aJ\n" +
+	"\x05stats\x18\x03 \x03(\v2 .rsk.SearchResultList.StatsEntryR\x05stats\x12\"\n" +
+	"\x06facets\x18\x04 \x03(\v2\n" +
+	".rsk.FacetR\x06facets\x12*\n" +
+	"\x11next_search_after\x18\x05 \x01(\tR\x0fnextSearchAfter\x12 \n" +
+	"\vsuggestions\x18\x06 \x03(\tR\vsuggestions\x128\n" +
+	"\vexplanation\x18\a \x01(\v2\x16.rsk.SearchExplanationR\vexplanation\x1aJ\n" +
 	"\n" +
 	"StatsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12&\n" +
-	"\x05value\x18\x02 \x01(\v2\x10.rsk.SearchStatsR\x05value:\x028\x01\"k\n" +
+	"\x05value\x18\x02 \x01(\v2\x10.rsk.SearchStatsR\x05value:\x028\x01\"A\n" +
+	"\x11SearchExplanation\x12\x16\n" +
+	"\x06filter\x18\x01 \x01(\tR\x06filter\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\"u\n" +
+	"\x10ScoreExplanation\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x02R\x05value\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\bchildren\x18\x03 \x03(\v2\x15.rsk.ScoreExplanationR\bchildren\"D\n" +
+	"\x05Facet\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12'\n" +
+	"\x06values\x18\x02 \x03(\v2\x0f.rsk.FieldValueR\x06values\"\xda\x01\n" +
 	"\fSearchResult\x12.\n" +
 	"\aepisode\x18\x01 \x01(\v2\x14.rsk.ShortTranscriptR\aepisode\x12+\n" +
-	"\adialogs\x18\x02 \x03(\v2\x11.rsk.DialogResultR\adialogs\"=\n" +
+	"\adialogs\x18\x02 \x03(\v2\x11.rsk.DialogResultR\adialogs\x12)\n" +
+	"\x06entity\x18\x03 \x01(\v2\x11.rsk.EntityResultR\x06entity\x12B\n" +
+	"\x11score_explanation\x18\x04 \x01(\v2\x15.rsk.ScoreExplanationR\x10scoreExplanation\"=\n" +
 	"\vSearchStats\x12\x16\n" +
 	"\x06labels\x18\x02 \x03(\tR\x06labels\x12\x16\n" +
 	"\x06values\x18\x03 \x03(\x02R\x06values\"Q\n" +
@@ -2036,7 +3416,13 @@ const file_search_proto_rawDesc = "" +
 	"\n" +
 	"transcript\x18\x01 \x03(\v2\v.rsk.DialogR\n" +
 	"transcript\x12\x14\n" +
-	"\x05score\x18\x02 \x01(\x02R\x05score\"k\n" +
+	"\x05score\x18\x02 \x01(\x02R\x05score\"\x8f\x01\n" +
+	"\fEntityResult\x12\x19\n" +
+	"\bdoc_type\x18\x01 \x01(\tR\adocType\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1b\n" +
+	"\tstart_pos\x18\x03 \x01(\x05R\bstartPos\x12\x17\n" +
+	"\aend_pos\x18\x04 \x01(\x05R\x06endPos\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x02R\x05score\"k\n" +
 	"\bMetadata\x123\n" +
 	"\rsearch_fields\x18\x01 \x03(\v2\x0e.rsk.FieldMetaR\fsearchFields\x12*\n" +
 	"\x11episode_short_ids\x18\x02 \x03(\tR\x0fepisodeShortIds\"\xb4\x01\n" +
@@ -2124,10 +3510,16 @@ const file_search_proto_rawDesc = "" +
 	"\vtranscribed\x18\a \x03(\tR\vtranscribed\"\x13\n" +
 	"\x11GetRoadmapRequest\"%\n" +
 	"\aRoadmap\x12\x1a\n" +
-	"\bmarkdown\x18\x01 \x01(\tR\bmarkdown2\xdd\t\n" +
+	"\bmarkdown\x18\x01 \x01(\tR\bmarkdown2\x9e\x0e\n" +
 	"\rSearchService\x12m\n" +
 	"\x06Search\x12\x12.rsk.SearchRequest\x1a\x15.rsk.SearchResultList\"8\x92A\"\n" +
-	"\x06search\x12\x10Perform a search*\x06search\x82\xd3\xe4\x93\x02\r\x12\v/api/search\x12\xad\x01\n" +
+	"\x06search\x12\x10Perform a search*\x06search\x82\xd3\xe4\x93\x02\r\x12\v/api/search\x12\xc8\x01\n" +
+	"\rSearchSimilar\x12\x19.rsk.SearchSimilarRequest\x1a\x15.rsk.SearchResultList\"\x84\x01\x92AZ\n" +
+	"\x06search\x12AFind lines from other episodes that are similar to the given line*\rsearchSimilar\x82\xd3\xe4\x93\x02!\x12\x1f/api/search/similar/{dialog_id}\x12\xbe\x01\n" +
+	"\x10GetTermFrequency\x12\x1c.rsk.GetTermFrequencyRequest\x1a\x12.rsk.TermFrequency\"x\x92AS\n" +
+	"\x06search\x127Get how often words or phrases are said in each episode*\x10getTermFrequency\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/search/term-frequency\x12\xb2\x01\n" +
+	"\vListPhrases\x12\x17.rsk.ListPhrasesRequest\x1a\x0f.rsk.PhraseList\"y\x92A[\n" +
+	"\x06search\x12DList the phrases that are said together most often e.g. catchphrases*\vlistPhrases\x82\xd3\xe4\x93\x02\x15\x12\x13/api/search/phrases\x12\xad\x01\n" +
 	"\vGetMetadata\x12\x16.google.protobuf.Empty\x1a\r.rsk.Metadata\"w\x92A_\n" +
 	"\x06search\x12HSearch related metadata (searchable fields, available publications etc.)*\vgetMetadata\x82\xd3\xe4\x93\x02\x0f\x12\r/api/metadata\x12\xae\x01\n" +
 	"\x0fListFieldValues\x12\x1b.rsk.ListFieldValuesRequest\x1a\x13.rsk.FieldValueList\"i\x92AK\n" +
@@ -2146,73 +3538,100 @@ const file_search_proto_rawDesc = "" +
 	"getRoadmap\x82\xd3\xe4\x93\x02\x0e\x12\f/api/roadmapBk\x92A:\x12\x052\x031.0*\x01\x01r.\n" +
 	"\x15Search transcriptions\x12\x15https://scrimpton.comZ,github.com/warmans/rsk-search/server/gen/apib\x06proto3"
 
-var file_search_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_search_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_search_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_search_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_search_proto_goTypes = []any{
-	(FieldMeta_Kind)(0),              // 0: rsk.FieldMeta.Kind
-	(*SearchRequest)(nil),            // 1: rsk.SearchRequest
-	(*SearchResultList)(nil),         // 2: rsk.SearchResultList
-	(*SearchResult)(nil),             // 3: rsk.SearchResult
-	(*SearchStats)(nil),              // 4: rsk.SearchStats
-	(*DialogResult)(nil),             // 5: rsk.DialogResult
-	(*Metadata)(nil),                 // 6: rsk.Metadata
-	(*FieldMeta)(nil),                // 7: rsk.FieldMeta
-	(*ListFieldValuesRequest)(nil),   // 8: rsk.ListFieldValuesRequest
-	(*FieldValueList)(nil),           // 9: rsk.FieldValueList
-	(*FieldValue)(nil),               // 10: rsk.FieldValue
-	(*PredictSearchTermRequest)(nil), // 11: rsk.PredictSearchTermRequest
-	(*SearchTermPredictions)(nil),    // 12: rsk.SearchTermPredictions
-	(*Prediction)(nil),               // 13: rsk.Prediction
-	(*WordPosition)(nil),             // 14: rsk.WordPosition
-	(*ListChangelogsRequest)(nil),    // 15: rsk.ListChangelogsRequest
-	(*ChangelogList)(nil),            // 16: rsk.ChangelogList
-	(*Changelog)(nil),                // 17: rsk.Changelog
-	(*GetRandomQuoteRequest)(nil),    // 18: rsk.GetRandomQuoteRequest
-	(*RandomQuote)(nil),              // 19: rsk.RandomQuote
-	(*ListSongsRequest)(nil),         // 20: rsk.ListSongsRequest
-	(*SongList)(nil),                 // 21: rsk.SongList
-	(*Song)(nil),                     // 22: rsk.Song
-	(*GetRoadmapRequest)(nil),        // 23: rsk.GetRoadmapRequest
-	(*Roadmap)(nil),                  // 24: rsk.Roadmap
-	nil,                              // 25: rsk.SearchResultList.StatsEntry
-	(*ShortTranscript)(nil),          // 26: rsk.ShortTranscript
-	(*Dialog)(nil),                   // 27: rsk.Dialog
-	(*emptypb.Empty)(nil),            // 28: google.protobuf.Empty
+	(ListPhrasesRequest_Scoring)(0),  // 0: rsk.ListPhrasesRequest.Scoring
+	(FieldMeta_Kind)(0),              // 1: rsk.FieldMeta.Kind
+	(*SearchRequest)(nil),            // 2: rsk.SearchRequest
+	(*GetTermFrequencyRequest)(nil),  // 3: rsk.GetTermFrequencyRequest
+	(*TermFrequency)(nil),            // 4: rsk.TermFrequency
+	(*TermFrequencySeries)(nil),      // 5: rsk.TermFrequencySeries
+	(*ListPhrasesRequest)(nil),       // 6: rsk.ListPhrasesRequest
+	(*PhraseList)(nil),               // 7: rsk.PhraseList
+	(*Phrase)(nil),                   // 8: rsk.Phrase
+	(*SearchSimilarRequest)(nil),     // 9: rsk.SearchSimilarRequest
+	(*SearchResultList)(nil),         // 10: rsk.SearchResultList
+	(*SearchExplanation)(nil),        // 11: rsk.SearchExplanation
+	(*ScoreExplanation)(nil),         // 12: rsk.ScoreExplanation
+	(*Facet)(nil),                    // 13: rsk.Facet
+	(*SearchResult)(nil),             // 14: rsk.SearchResult
+	(*SearchStats)(nil),              // 15: rsk.SearchStats
+	(*DialogResult)(nil),             // 16: rsk.DialogResult
+	(*EntityResult)(nil),             // 17: rsk.EntityResult
+	(*Metadata)(nil),                 // 18: rsk.Metadata
+	(*FieldMeta)(nil),                // 19: rsk.FieldMeta
+	(*ListFieldValuesRequest)(nil),   // 20: rsk.ListFieldValuesRequest
+	(*FieldValueList)(nil),           // 21: rsk.FieldValueList
+	(*FieldValue)(nil),               // 22: rsk.FieldValue
+	(*PredictSearchTermRequest)(nil), // 23: rsk.PredictSearchTermRequest
+	(*SearchTermPredictions)(nil),    // 24: rsk.SearchTermPredictions
+	(*Prediction)(nil),               // 25: rsk.Prediction
+	(*WordPosition)(nil),             // 26: rsk.WordPosition
+	(*ListChangelogsRequest)(nil),    // 27: rsk.ListChangelogsRequest
+	(*ChangelogList)(nil),            // 28: rsk.ChangelogList
+	(*Changelog)(nil),                // 29: rsk.Changelog
+	(*GetRandomQuoteRequest)(nil),    // 30: rsk.GetRandomQuoteRequest
+	(*RandomQuote)(nil),              // 31: rsk.RandomQuote
+	(*ListSongsRequest)(nil),         // 32: rsk.ListSongsRequest
+	(*SongList)(nil),                 // 33: rsk.SongList
+	(*Song)(nil),                     // 34: rsk.Song
+	(*GetRoadmapRequest)(nil),        // 35: rsk.GetRoadmapRequest
+	(*Roadmap)(nil),                  // 36: rsk.Roadmap
+	nil,                              // 37: rsk.SearchResultList.StatsEntry
+	(*ShortTranscript)(nil),          // 38: rsk.ShortTranscript
+	(*Dialog)(nil),                   // 39: rsk.Dialog
+	(*emptypb.Empty)(nil),            // 40: google.protobuf.Empty
 }
 var file_search_proto_depIdxs = []int32{
-	3,  // 0: rsk.SearchResultList.results:type_name -> rsk.SearchResult
-	25, // 1: rsk.SearchResultList.stats:type_name -> rsk.SearchResultList.StatsEntry
-	26, // 2: rsk.SearchResult.episode:type_name -> rsk.ShortTranscript
-	5,  // 3: rsk.SearchResult.dialogs:type_name -> rsk.DialogResult
-	27, // 4: rsk.DialogResult.transcript:type_name -> rsk.Dialog
-	7,  // 5: rsk.Metadata.search_fields:type_name -> rsk.FieldMeta
-	0,  // 6: rsk.FieldMeta.kind:type_name -> rsk.FieldMeta.Kind
-	10, // 7: rsk.FieldValueList.values:type_name -> rsk.FieldValue
-	13, // 8: rsk.SearchTermPredictions.predictions:type_name -> rsk.Prediction
-	17, // 9: rsk.ChangelogList.changelogs:type_name -> rsk.Changelog
-	22, // 10: rsk.SongList.songs:type_name -> rsk.Song
-	4,  // 11: rsk.SearchResultList.StatsEntry.value:type_name -> rsk.SearchStats
-	1,  // 12: rsk.SearchService.Search:input_type -> rsk.SearchRequest
-	28, // 13: rsk.SearchService.GetMetadata:input_type -> google.protobuf.Empty
-	8,  // 14: rsk.SearchService.ListFieldValues:input_type -> rsk.ListFieldValuesRequest
-	11, // 15: rsk.SearchService.PredictSearchTerm:input_type -> rsk.PredictSearchTermRequest
-	18, // 16: rsk.SearchService.GetRandomQuote:input_type -> rsk.GetRandomQuoteRequest
-	20, // 17: rsk.SearchService.ListSongs:input_type -> rsk.ListSongsRequest
-	15, // 18: rsk.SearchService.ListChangelogs:input_type -> rsk.ListChangelogsRequest
-	23, // 19: rsk.SearchService.GetRoadmap:input_type -> rsk.GetRoadmapRequest
-	2,  // 20: rsk.SearchService.Search:output_type -> rsk.SearchResultList
-	6,  // 21: rsk.SearchService.GetMetadata:output_type -> rsk.Metadata
-	9,  // 22: rsk.SearchService.ListFieldValues:output_type -> rsk.FieldValueList
-	12, // 23: rsk.SearchService.PredictSearchTerm:output_type -> rsk.SearchTermPredictions
-	19, // 24: rsk.SearchService.GetRandomQuote:output_type -> rsk.RandomQuote
-	21, // 25: rsk.SearchService.ListSongs:output_type -> rsk.SongList
-	16, // 26: rsk.SearchService.ListChangelogs:output_type -> rsk.ChangelogList
-	24, // 27: rsk.SearchService.GetRoadmap:output_type -> rsk.Roadmap
-	20, // [20:28] is the sub-list for method output_type
-	12, // [12:20] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	5,  // 0: rsk.TermFrequency.series:type_name -> rsk.TermFrequencySeries
+	0,  // 1: rsk.ListPhrasesRequest.scoring:type_name -> rsk.ListPhrasesRequest.Scoring
+	8,  // 2: rsk.PhraseList.phrases:type_name -> rsk.Phrase
+	14, // 3: rsk.SearchResultList.results:type_name -> rsk.SearchResult
+	37, // 4: rsk.SearchResultList.stats:type_name -> rsk.SearchResultList.StatsEntry
+	13, // 5: rsk.SearchResultList.facets:type_name -> rsk.Facet
+	11, // 6: rsk.SearchResultList.explanation:type_name -> rsk.SearchExplanation
+	12, // 7: rsk.ScoreExplanation.children:type_name -> rsk.ScoreExplanation
+	22, // 8: rsk.Facet.values:type_name -> rsk.FieldValue
+	38, // 9: rsk.SearchResult.episode:type_name -> rsk.ShortTranscript
+	16, // 10: rsk.SearchResult.dialogs:type_name -> rsk.DialogResult
+	17, // 11: rsk.SearchResult.entity:type_name -> rsk.EntityResult
+	12, // 12: rsk.SearchResult.score_explanation:type_name -> rsk.ScoreExplanation
+	39, // 13: rsk.DialogResult.transcript:type_name -> rsk.Dialog
+	19, // 14: rsk.Metadata.search_fields:type_name -> rsk.FieldMeta
+	1,  // 15: rsk.FieldMeta.kind:type_name -> rsk.FieldMeta.Kind
+	22, // 16: rsk.FieldValueList.values:type_name -> rsk.FieldValue
+	25, // 17: rsk.SearchTermPredictions.predictions:type_name -> rsk.Prediction
+	29, // 18: rsk.ChangelogList.changelogs:type_name -> rsk.Changelog
+	34, // 19: rsk.SongList.songs:type_name -> rsk.Song
+	15, // 20: rsk.SearchResultList.StatsEntry.value:type_name -> rsk.SearchStats
+	2,  // 21: rsk.SearchService.Search:input_type -> rsk.SearchRequest
+	9,  // 22: rsk.SearchService.SearchSimilar:input_type -> rsk.SearchSimilarRequest
+	3,  // 23: rsk.SearchService.GetTermFrequency:input_type -> rsk.GetTermFrequencyRequest
+	6,  // 24: rsk.SearchService.ListPhrases:input_type -> rsk.ListPhrasesRequest
+	40, // 25: rsk.SearchService.GetMetadata:input_type -> google.protobuf.Empty
+	20, // 26: rsk.SearchService.ListFieldValues:input_type -> rsk.ListFieldValuesRequest
+	23, // 27: rsk.SearchService.PredictSearchTerm:input_type -> rsk.PredictSearchTermRequest
+	30, // 28: rsk.SearchService.GetRandomQuote:input_type -> rsk.GetRandomQuoteRequest
+	32, // 29: rsk.SearchService.ListSongs:input_type -> rsk.ListSongsRequest
+	27, // 30: rsk.SearchService.ListChangelogs:input_type -> rsk.ListChangelogsRequest
+	35, // 31: rsk.SearchService.GetRoadmap:input_type -> rsk.GetRoadmapRequest
+	10, // 32: rsk.SearchService.Search:output_type -> rsk.SearchResultList
+	10, // 33: rsk.SearchService.SearchSimilar:output_type -> rsk.SearchResultList
+	4,  // 34: rsk.SearchService.GetTermFrequency:output_type -> rsk.TermFrequency
+	7,  // 35: rsk.SearchService.ListPhrases:output_type -> rsk.PhraseList
+	18, // 36: rsk.SearchService.GetMetadata:output_type -> rsk.Metadata
+	21, // 37: rsk.SearchService.ListFieldValues:output_type -> rsk.FieldValueList
+	24, // 38: rsk.SearchService.PredictSearchTerm:output_type -> rsk.SearchTermPredictions
+	31, // 39: rsk.SearchService.GetRandomQuote:output_type -> rsk.RandomQuote
+	33, // 40: rsk.SearchService.ListSongs:output_type -> rsk.SongList
+	28, // 41: rsk.SearchService.ListChangelogs:output_type -> rsk.ChangelogList
+	36, // 42: rsk.SearchService.GetRoadmap:output_type -> rsk.Roadmap
+	32, // [32:43] is the sub-list for method output_type
+	21, // [21:32] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_search_proto_init() }
@@ -2226,8 +3645,8 @@ func file_search_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_search_proto_rawDesc), len(file_search_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   25,
+			NumEnums:      2,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,6 +21,9 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	SearchService_Search_FullMethodName            = "/rsk.SearchService/Search"
+	SearchService_SearchSimilar_FullMethodName     = "/rsk.SearchService/SearchSimilar"
+	SearchService_GetTermFrequency_FullMethodName  = "/rsk.SearchService/GetTermFrequency"
+	SearchService_ListPhrases_FullMethodName       = "/rsk.SearchService/ListPhrases"
 	SearchService_GetMetadata_FullMethodName       = "/rsk.SearchService/GetMetadata"
 	SearchService_ListFieldValues_FullMethodName   = "/rsk.SearchService/ListFieldValues"
 	SearchService_PredictSearchTerm_FullMethodName = "/rsk.SearchService/PredictSearchTerm"
@@ -35,6 +38,9 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SearchServiceClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResultList, error)
+	SearchSimilar(ctx context.Context, in *SearchSimilarRequest, opts ...grpc.CallOption) (*SearchResultList, error)
+	GetTermFrequency(ctx context.Context, in *GetTermFrequencyRequest, opts ...grpc.CallOption) (*TermFrequency, error)
+	ListPhrases(ctx context.Context, in *ListPhrasesRequest, opts ...grpc.CallOption) (*PhraseList, error)
 	GetMetadata(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Metadata, error)
 	ListFieldValues(ctx context.Context, in *ListFieldValuesRequest, opts ...grpc.CallOption) (*FieldValueList, error)
 	PredictSearchTerm(ctx context.Context, in *PredictSearchTermRequest, opts ...grpc.CallOption) (*SearchTermPredictions, error)
@@ -62,6 +68,36 @@ func (c *searchServiceClient) Search(ctx context.Context, in *SearchRequest, opt
 	return out, nil
 }
 
+func (c *searchServiceClient) SearchSimilar(ctx context.Context, in *SearchSimilarRequest, opts ...grpc.CallOption) (*SearchResultList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResultList)
+	err := c.cc.Invoke(ctx, SearchService_SearchSimilar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchServiceClient) GetTermFrequency(ctx context.Context, in *GetTermFrequencyRequest, opts ...grpc.CallOption) (*TermFrequency, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TermFrequency)
+	err := c.cc.Invoke(ctx, SearchService_GetTermFrequency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchServiceClient) ListPhrases(ctx context.Context, in *ListPhrasesRequest, opts ...grpc.CallOption) (*PhraseList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PhraseList)
+	err := c.cc.Invoke(ctx, SearchService_ListPhrases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *searchServiceClient) GetMetadata(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Metadata, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Metadata)
@@ -137,6 +173,9 @@ func (c *searchServiceClient) GetRoadmap(ctx context.Context, in *GetRoadmapRequ
 // for forward compatibility.
 type SearchServiceServer interface {
 	Search(context.Context, *SearchRequest) (*SearchResultList, error)
+	SearchSimilar(context.Context, *SearchSimilarRequest) (*SearchResultList, error)
+	GetTermFrequency(context.Context, *GetTermFrequencyRequest) (*TermFrequency, error)
+	ListPhrases(context.Context, *ListPhrasesRequest) (*PhraseList, error)
 	GetMetadata(context.Context, *emptypb.Empty) (*Metadata, error)
 	ListFieldValues(context.Context, *ListFieldValuesRequest) (*FieldValueList, error)
 	PredictSearchTerm(context.Context, *PredictSearchTermRequest) (*SearchTermPredictions, error)
@@ -156,6 +195,15 @@ type UnimplementedSearchServiceServer struct{}
 func (UnimplementedSearchServiceServer) Search(context.Context, *SearchRequest) (*SearchResultList, error) {
 	return nil, status.Error(codes.Unimplemented, "method Search not implemented")
 }
+func (UnimplementedSearchServiceServer) SearchSimilar(context.Context, *SearchSimilarRequest) (*SearchResultList, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchSimilar not implemented")
+}
+func (UnimplementedSearchServiceServer) GetTermFrequency(context.Context, *GetTermFrequencyRequest) (*TermFrequency, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTermFrequency not implemented")
+}
+func (UnimplementedSearchServiceServer) ListPhrases(context.Context, *ListPhrasesRequest) (*PhraseList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPhrases not implemented")
+}
 func (UnimplementedSearchServiceServer) GetMetadata(context.Context, *emptypb.Empty) (*Metadata, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMetadata not implemented")
 }
@@ -215,6 +263,60 @@ func _SearchService_Search_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SearchService_SearchSimilar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSimilarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).SearchSimilar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_SearchSimilar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).SearchSimilar(ctx, req.(*SearchSimilarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchService_GetTermFrequency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTermFrequencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).GetTermFrequency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_GetTermFrequency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).GetTermFrequency(ctx, req.(*GetTermFrequencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchService_ListPhrases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPhrasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).ListPhrases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_ListPhrases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).ListPhrases(ctx, req.(*ListPhrasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SearchService_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -352,6 +454,18 @@ var SearchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Search",
 			Handler:    _SearchService_Search_Handler,
 		},
+		{
+			MethodName: "SearchSimilar",
+			Handler:    _SearchService_SearchSimilar_Handler,
+		},
+		{
+			MethodName: "GetTermFrequency",
+			Handler:    _SearchService_GetTermFrequency_Handler,
+		},
+		{
+			MethodName: "ListPhrases",
+			Handler:    _SearchService_ListPhrases_Handler,
+		},
 		{
 			MethodName: "GetMetadata",
 			Handler:    _SearchService_GetMetadata_Handler,
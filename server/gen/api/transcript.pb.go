@@ -225,6 +225,153 @@ func (x Dialog_DialogType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
+type DialogDiff_Kind int32
+
+const (
+	DialogDiff_KIND_UNDEFINED DialogDiff_Kind = 0
+	DialogDiff_INSERT         DialogDiff_Kind = 1
+	DialogDiff_DELETE         DialogDiff_Kind = 2
+	DialogDiff_ACTOR_CHANGE   DialogDiff_Kind = 3
+	DialogDiff_CONTENT_CHANGE DialogDiff_Kind = 4
+	DialogDiff_OFFSET_CHANGE  DialogDiff_Kind = 5
+)
+
+// Enum value maps for DialogDiff_Kind.
+var (
+	DialogDiff_Kind_name = map[int32]string{
+		0: "KIND_UNDEFINED",
+		1: "INSERT",
+		2: "DELETE",
+		3: "ACTOR_CHANGE",
+		4: "CONTENT_CHANGE",
+		5: "OFFSET_CHANGE",
+	}
+	DialogDiff_Kind_value = map[string]int32{
+		"KIND_UNDEFINED": 0,
+		"INSERT":         1,
+		"DELETE":         2,
+		"ACTOR_CHANGE":   3,
+		"CONTENT_CHANGE": 4,
+		"OFFSET_CHANGE":  5,
+	}
+)
+
+func (x DialogDiff_Kind) Enum() *DialogDiff_Kind {
+	p := new(DialogDiff_Kind)
+	*p = x
+	return p
+}
+
+func (x DialogDiff_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DialogDiff_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_transcript_proto_enumTypes[4].Descriptor()
+}
+
+func (DialogDiff_Kind) Type() protoreflect.EnumType {
+	return &file_transcript_proto_enumTypes[4]
+}
+
+func (x DialogDiff_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type WordDiff_Op int32
+
+const (
+	WordDiff_EQUAL  WordDiff_Op = 0
+	WordDiff_INSERT WordDiff_Op = 1
+	WordDiff_DELETE WordDiff_Op = 2
+)
+
+// Enum value maps for WordDiff_Op.
+var (
+	WordDiff_Op_name = map[int32]string{
+		0: "EQUAL",
+		1: "INSERT",
+		2: "DELETE",
+	}
+	WordDiff_Op_value = map[string]int32{
+		"EQUAL":  0,
+		"INSERT": 1,
+		"DELETE": 2,
+	}
+)
+
+func (x WordDiff_Op) Enum() *WordDiff_Op {
+	p := new(WordDiff_Op)
+	*p = x
+	return p
+}
+
+func (x WordDiff_Op) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WordDiff_Op) Descriptor() protoreflect.EnumDescriptor {
+	return file_transcript_proto_enumTypes[5].Descriptor()
+}
+
+func (WordDiff_Op) Type() protoreflect.EnumType {
+	return &file_transcript_proto_enumTypes[5]
+}
+
+func (x WordDiff_Op) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type RangeDiff_Kind int32
+
+const (
+	RangeDiff_KIND_UNDEFINED     RangeDiff_Kind = 0
+	RangeDiff_INSERT             RangeDiff_Kind = 1
+	RangeDiff_DELETE             RangeDiff_Kind = 2
+	RangeDiff_DESCRIPTION_CHANGE RangeDiff_Kind = 3
+	RangeDiff_RANGE_CHANGE       RangeDiff_Kind = 4
+)
+
+// Enum value maps for RangeDiff_Kind.
+var (
+	RangeDiff_Kind_name = map[int32]string{
+		0: "KIND_UNDEFINED",
+		1: "INSERT",
+		2: "DELETE",
+		3: "DESCRIPTION_CHANGE",
+		4: "RANGE_CHANGE",
+	}
+	RangeDiff_Kind_value = map[string]int32{
+		"KIND_UNDEFINED":     0,
+		"INSERT":             1,
+		"DELETE":             2,
+		"DESCRIPTION_CHANGE": 3,
+		"RANGE_CHANGE":       4,
+	}
+)
+
+func (x RangeDiff_Kind) Enum() *RangeDiff_Kind {
+	p := new(RangeDiff_Kind)
+	*p = x
+	return p
+}
+
+func (x RangeDiff_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RangeDiff_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_transcript_proto_enumTypes[6].Descriptor()
+}
+
+func (RangeDiff_Kind) Type() protoreflect.EnumType {
+	return &file_transcript_proto_enumTypes[6]
+}
+
+func (x RangeDiff_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 type Transcript struct {
 	state              protoimpl.MessageState `protogen:"hybrid.v1"`
 	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -4267,19 +4414,20 @@ func (b0 TranscriptChangeList_builder) Build() *TranscriptChangeList {
 }
 
 type TranscriptChange struct {
-	state             protoimpl.MessageState `protogen:"hybrid.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	EpisodeId         string                 `protobuf:"bytes,2,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
-	Summary           string                 `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
-	Transcript        string                 `protobuf:"bytes,4,opt,name=transcript,proto3" json:"transcript,omitempty"`
-	State             ContributionState      `protobuf:"varint,6,opt,name=state,proto3,enum=rsk.ContributionState" json:"state,omitempty"`
-	Author            *Author                `protobuf:"bytes,7,opt,name=author,proto3" json:"author,omitempty"`
-	CreatedAt         string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Merged            bool                   `protobuf:"varint,9,opt,name=merged,proto3" json:"merged,omitempty"`
-	PointsAwarded     float32                `protobuf:"fixed32,10,opt,name=points_awarded,json=pointsAwarded,proto3" json:"points_awarded,omitempty"`
-	TranscriptVersion string                 `protobuf:"bytes,11,opt,name=transcript_version,json=transcriptVersion,proto3" json:"transcript_version,omitempty"`
-	Name              string                 `protobuf:"bytes,12,opt,name=name,proto3" json:"name,omitempty"`
-	ReleaseDate       string                 `protobuf:"bytes,13,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	state             protoimpl.MessageState   `protogen:"hybrid.v1"`
+	Id                string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EpisodeId         string                   `protobuf:"bytes,2,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	Summary           string                   `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Transcript        string                   `protobuf:"bytes,4,opt,name=transcript,proto3" json:"transcript,omitempty"`
+	State             ContributionState        `protobuf:"varint,6,opt,name=state,proto3,enum=rsk.ContributionState" json:"state,omitempty"`
+	Author            *Author                  `protobuf:"bytes,7,opt,name=author,proto3" json:"author,omitempty"`
+	CreatedAt         string                   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Merged            bool                     `protobuf:"varint,9,opt,name=merged,proto3" json:"merged,omitempty"`
+	PointsAwarded     float32                  `protobuf:"fixed32,10,opt,name=points_awarded,json=pointsAwarded,proto3" json:"points_awarded,omitempty"`
+	TranscriptVersion string                   `protobuf:"bytes,11,opt,name=transcript_version,json=transcriptVersion,proto3" json:"transcript_version,omitempty"`
+	Name              string                   `protobuf:"bytes,12,opt,name=name,proto3" json:"name,omitempty"`
+	ReleaseDate       string                   `protobuf:"bytes,13,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	LintWarnings      []*TranscriptLintWarning `protobuf:"bytes,14,rep,name=lint_warnings,json=lintWarnings,proto3" json:"lint_warnings,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -4393,6 +4541,13 @@ func (x *TranscriptChange) GetReleaseDate() string {
 	return ""
 }
 
+func (x *TranscriptChange) GetLintWarnings() []*TranscriptLintWarning {
+	if x != nil {
+		return x.LintWarnings
+	}
+	return nil
+}
+
 func (x *TranscriptChange) SetId(v string) {
 	x.Id = v
 }
@@ -4441,6 +4596,10 @@ func (x *TranscriptChange) SetReleaseDate(v string) {
 	x.ReleaseDate = v
 }
 
+func (x *TranscriptChange) SetLintWarnings(v []*TranscriptLintWarning) {
+	x.LintWarnings = v
+}
+
 func (x *TranscriptChange) HasAuthor() bool {
 	if x == nil {
 		return false
@@ -4467,6 +4626,7 @@ type TranscriptChange_builder struct {
 	TranscriptVersion string
 	Name              string
 	ReleaseDate       string
+	LintWarnings      []*TranscriptLintWarning
 }
 
 func (b0 TranscriptChange_builder) Build() *TranscriptChange {
@@ -4485,6 +4645,109 @@ func (b0 TranscriptChange_builder) Build() *TranscriptChange {
 	x.TranscriptVersion = b.TranscriptVersion
 	x.Name = b.Name
 	x.ReleaseDate = b.ReleaseDate
+	x.LintWarnings = b.LintWarnings
+	return m0
+}
+
+// TranscriptLintWarning is a possible problem found in a transcript. Warnings do not stop a change being saved.
+type TranscriptLintWarning struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	Rule  string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// one of info, warning or error.
+	Severity      string `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`
+	Line          int32  `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TranscriptLintWarning) Reset() {
+	*x = TranscriptLintWarning{}
+	mi := &file_transcript_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TranscriptLintWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranscriptLintWarning) ProtoMessage() {}
+
+func (x *TranscriptLintWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_transcript_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TranscriptLintWarning) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *TranscriptLintWarning) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *TranscriptLintWarning) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *TranscriptLintWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TranscriptLintWarning) SetRule(v string) {
+	x.Rule = v
+}
+
+func (x *TranscriptLintWarning) SetSeverity(v string) {
+	x.Severity = v
+}
+
+func (x *TranscriptLintWarning) SetLine(v int32) {
+	x.Line = v
+}
+
+func (x *TranscriptLintWarning) SetMessage(v string) {
+	x.Message = v
+}
+
+type TranscriptLintWarning_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Rule string
+	// one of info, warning or error.
+	Severity string
+	Line     int32
+	Message  string
+}
+
+func (b0 TranscriptLintWarning_builder) Build() *TranscriptLintWarning {
+	m0 := &TranscriptLintWarning{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Rule = b.Rule
+	x.Severity = b.Severity
+	x.Line = b.Line
+	x.Message = b.Message
 	return m0
 }
 
@@ -4504,7 +4767,7 @@ type ShortTranscriptChange struct {
 
 func (x *ShortTranscriptChange) Reset() {
 	*x = ShortTranscriptChange{}
-	mi := &file_transcript_proto_msgTypes[36]
+	mi := &file_transcript_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4516,7 +4779,7 @@ func (x *ShortTranscriptChange) String() string {
 func (*ShortTranscriptChange) ProtoMessage() {}
 
 func (x *ShortTranscriptChange) ProtoReflect() protoreflect.Message {
-	mi := &file_transcript_proto_msgTypes[36]
+	mi := &file_transcript_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4665,7 +4928,7 @@ type RequestTranscriptChangeStateRequest struct {
 
 func (x *RequestTranscriptChangeStateRequest) Reset() {
 	*x = RequestTranscriptChangeStateRequest{}
-	mi := &file_transcript_proto_msgTypes[37]
+	mi := &file_transcript_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4677,7 +4940,7 @@ func (x *RequestTranscriptChangeStateRequest) String() string {
 func (*RequestTranscriptChangeStateRequest) ProtoMessage() {}
 
 func (x *RequestTranscriptChangeStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transcript_proto_msgTypes[37]
+	mi := &file_transcript_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4748,7 +5011,7 @@ type GetTranscriptChangeRequest struct {
 
 func (x *GetTranscriptChangeRequest) Reset() {
 	*x = GetTranscriptChangeRequest{}
-	mi := &file_transcript_proto_msgTypes[38]
+	mi := &file_transcript_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4760,7 +5023,7 @@ func (x *GetTranscriptChangeRequest) String() string {
 func (*GetTranscriptChangeRequest) ProtoMessage() {}
 
 func (x *GetTranscriptChangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transcript_proto_msgTypes[38]
+	mi := &file_transcript_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4805,7 +5068,7 @@ type GetTranscriptChangeDiffRequest struct {
 
 func (x *GetTranscriptChangeDiffRequest) Reset() {
 	*x = GetTranscriptChangeDiffRequest{}
-	mi := &file_transcript_proto_msgTypes[39]
+	mi := &file_transcript_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4817,7 +5080,7 @@ func (x *GetTranscriptChangeDiffRequest) String() string {
 func (*GetTranscriptChangeDiffRequest) ProtoMessage() {}
 
 func (x *GetTranscriptChangeDiffRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transcript_proto_msgTypes[39]
+	mi := &file_transcript_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4856,13 +5119,16 @@ func (b0 GetTranscriptChangeDiffRequest_builder) Build() *GetTranscriptChangeDif
 type TranscriptChangeDiff struct {
 	state         protoimpl.MessageState `protogen:"hybrid.v1"`
 	Diffs         []string               `protobuf:"bytes,2,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	DialogDiffs   []*DialogDiff          `protobuf:"bytes,3,rep,name=dialog_diffs,json=dialogDiffs,proto3" json:"dialog_diffs,omitempty"`
+	SynopsisDiffs []*RangeDiff           `protobuf:"bytes,4,rep,name=synopsis_diffs,json=synopsisDiffs,proto3" json:"synopsis_diffs,omitempty"`
+	TriviaDiffs   []*RangeDiff           `protobuf:"bytes,5,rep,name=trivia_diffs,json=triviaDiffs,proto3" json:"trivia_diffs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TranscriptChangeDiff) Reset() {
 	*x = TranscriptChangeDiff{}
-	mi := &file_transcript_proto_msgTypes[40]
+	mi := &file_transcript_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4874,7 +5140,7 @@ func (x *TranscriptChangeDiff) String() string {
 func (*TranscriptChangeDiff) ProtoMessage() {}
 
 func (x *TranscriptChangeDiff) ProtoReflect() protoreflect.Message {
-	mi := &file_transcript_proto_msgTypes[40]
+	mi := &file_transcript_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4892,14 +5158,50 @@ func (x *TranscriptChangeDiff) GetDiffs() []string {
 	return nil
 }
 
+func (x *TranscriptChangeDiff) GetDialogDiffs() []*DialogDiff {
+	if x != nil {
+		return x.DialogDiffs
+	}
+	return nil
+}
+
+func (x *TranscriptChangeDiff) GetSynopsisDiffs() []*RangeDiff {
+	if x != nil {
+		return x.SynopsisDiffs
+	}
+	return nil
+}
+
+func (x *TranscriptChangeDiff) GetTriviaDiffs() []*RangeDiff {
+	if x != nil {
+		return x.TriviaDiffs
+	}
+	return nil
+}
+
 func (x *TranscriptChangeDiff) SetDiffs(v []string) {
 	x.Diffs = v
 }
 
+func (x *TranscriptChangeDiff) SetDialogDiffs(v []*DialogDiff) {
+	x.DialogDiffs = v
+}
+
+func (x *TranscriptChangeDiff) SetSynopsisDiffs(v []*RangeDiff) {
+	x.SynopsisDiffs = v
+}
+
+func (x *TranscriptChangeDiff) SetTriviaDiffs(v []*RangeDiff) {
+	x.TriviaDiffs = v
+}
+
 type TranscriptChangeDiff_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Diffs []string
+	Diffs         []string
+	DialogDiffs   []*DialogDiff
+	SynopsisDiffs []*RangeDiff
+	TriviaDiffs   []*RangeDiff
 }
 
 func (b0 TranscriptChangeDiff_builder) Build() *TranscriptChangeDiff {
@@ -4907,6 +5209,351 @@ func (b0 TranscriptChangeDiff_builder) Build() *TranscriptChangeDiff {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.Diffs = b.Diffs
+	x.DialogDiffs = b.DialogDiffs
+	x.SynopsisDiffs = b.SynopsisDiffs
+	x.TriviaDiffs = b.TriviaDiffs
+	return m0
+}
+
+// DialogDiff is a single change to the dialog. A line can have more than one change e.g. if both the actor
+// and content were changed.
+type DialogDiff struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	Kind  DialogDiff_Kind        `protobuf:"varint,1,opt,name=kind,proto3,enum=rsk.DialogDiff_Kind" json:"kind,omitempty"`
+	// not set for inserts.
+	OldDialog *Dialog `protobuf:"bytes,2,opt,name=old_dialog,json=oldDialog,proto3" json:"old_dialog,omitempty"`
+	// not set for deletes.
+	NewDialog *Dialog `protobuf:"bytes,3,opt,name=new_dialog,json=newDialog,proto3" json:"new_dialog,omitempty"`
+	// only set for content changes.
+	Words         []*WordDiff `protobuf:"bytes,4,rep,name=words,proto3" json:"words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DialogDiff) Reset() {
+	*x = DialogDiff{}
+	mi := &file_transcript_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DialogDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DialogDiff) ProtoMessage() {}
+
+func (x *DialogDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_transcript_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DialogDiff) GetKind() DialogDiff_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return DialogDiff_KIND_UNDEFINED
+}
+
+func (x *DialogDiff) GetOldDialog() *Dialog {
+	if x != nil {
+		return x.OldDialog
+	}
+	return nil
+}
+
+func (x *DialogDiff) GetNewDialog() *Dialog {
+	if x != nil {
+		return x.NewDialog
+	}
+	return nil
+}
+
+func (x *DialogDiff) GetWords() []*WordDiff {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *DialogDiff) SetKind(v DialogDiff_Kind) {
+	x.Kind = v
+}
+
+func (x *DialogDiff) SetOldDialog(v *Dialog) {
+	x.OldDialog = v
+}
+
+func (x *DialogDiff) SetNewDialog(v *Dialog) {
+	x.NewDialog = v
+}
+
+func (x *DialogDiff) SetWords(v []*WordDiff) {
+	x.Words = v
+}
+
+func (x *DialogDiff) HasOldDialog() bool {
+	if x == nil {
+		return false
+	}
+	return x.OldDialog != nil
+}
+
+func (x *DialogDiff) HasNewDialog() bool {
+	if x == nil {
+		return false
+	}
+	return x.NewDialog != nil
+}
+
+func (x *DialogDiff) ClearOldDialog() {
+	x.OldDialog = nil
+}
+
+func (x *DialogDiff) ClearNewDialog() {
+	x.NewDialog = nil
+}
+
+type DialogDiff_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Kind DialogDiff_Kind
+	// not set for inserts.
+	OldDialog *Dialog
+	// not set for deletes.
+	NewDialog *Dialog
+	// only set for content changes.
+	Words []*WordDiff
+}
+
+func (b0 DialogDiff_builder) Build() *DialogDiff {
+	m0 := &DialogDiff{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Kind = b.Kind
+	x.OldDialog = b.OldDialog
+	x.NewDialog = b.NewDialog
+	x.Words = b.Words
+	return m0
+}
+
+type WordDiff struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Op            WordDiff_Op            `protobuf:"varint,1,opt,name=op,proto3,enum=rsk.WordDiff_Op" json:"op,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordDiff) Reset() {
+	*x = WordDiff{}
+	mi := &file_transcript_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordDiff) ProtoMessage() {}
+
+func (x *WordDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_transcript_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *WordDiff) GetOp() WordDiff_Op {
+	if x != nil {
+		return x.Op
+	}
+	return WordDiff_EQUAL
+}
+
+func (x *WordDiff) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *WordDiff) SetOp(v WordDiff_Op) {
+	x.Op = v
+}
+
+func (x *WordDiff) SetText(v string) {
+	x.Text = v
+}
+
+type WordDiff_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Op   WordDiff_Op
+	Text string
+}
+
+func (b0 WordDiff_builder) Build() *WordDiff {
+	m0 := &WordDiff{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Op = b.Op
+	x.Text = b.Text
+	return m0
+}
+
+// RangeDiff is a change to a synopsis or trivia.
+type RangeDiff struct {
+	state          protoimpl.MessageState `protogen:"hybrid.v1"`
+	Kind           RangeDiff_Kind         `protobuf:"varint,1,opt,name=kind,proto3,enum=rsk.RangeDiff_Kind" json:"kind,omitempty"`
+	OldDescription string                 `protobuf:"bytes,2,opt,name=old_description,json=oldDescription,proto3" json:"old_description,omitempty"`
+	OldStartPos    int32                  `protobuf:"varint,3,opt,name=old_start_pos,json=oldStartPos,proto3" json:"old_start_pos,omitempty"`
+	OldEndPos      int32                  `protobuf:"varint,4,opt,name=old_end_pos,json=oldEndPos,proto3" json:"old_end_pos,omitempty"`
+	NewDescription string                 `protobuf:"bytes,5,opt,name=new_description,json=newDescription,proto3" json:"new_description,omitempty"`
+	NewStartPos    int32                  `protobuf:"varint,6,opt,name=new_start_pos,json=newStartPos,proto3" json:"new_start_pos,omitempty"`
+	NewEndPos      int32                  `protobuf:"varint,7,opt,name=new_end_pos,json=newEndPos,proto3" json:"new_end_pos,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RangeDiff) Reset() {
+	*x = RangeDiff{}
+	mi := &file_transcript_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RangeDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeDiff) ProtoMessage() {}
+
+func (x *RangeDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_transcript_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RangeDiff) GetKind() RangeDiff_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return RangeDiff_KIND_UNDEFINED
+}
+
+func (x *RangeDiff) GetOldDescription() string {
+	if x != nil {
+		return x.OldDescription
+	}
+	return ""
+}
+
+func (x *RangeDiff) GetOldStartPos() int32 {
+	if x != nil {
+		return x.OldStartPos
+	}
+	return 0
+}
+
+func (x *RangeDiff) GetOldEndPos() int32 {
+	if x != nil {
+		return x.OldEndPos
+	}
+	return 0
+}
+
+func (x *RangeDiff) GetNewDescription() string {
+	if x != nil {
+		return x.NewDescription
+	}
+	return ""
+}
+
+func (x *RangeDiff) GetNewStartPos() int32 {
+	if x != nil {
+		return x.NewStartPos
+	}
+	return 0
+}
+
+func (x *RangeDiff) GetNewEndPos() int32 {
+	if x != nil {
+		return x.NewEndPos
+	}
+	return 0
+}
+
+func (x *RangeDiff) SetKind(v RangeDiff_Kind) {
+	x.Kind = v
+}
+
+func (x *RangeDiff) SetOldDescription(v string) {
+	x.OldDescription = v
+}
+
+func (x *RangeDiff) SetOldStartPos(v int32) {
+	x.OldStartPos = v
+}
+
+func (x *RangeDiff) SetOldEndPos(v int32) {
+	x.OldEndPos = v
+}
+
+func (x *RangeDiff) SetNewDescription(v string) {
+	x.NewDescription = v
+}
+
+func (x *RangeDiff) SetNewStartPos(v int32) {
+	x.NewStartPos = v
+}
+
+func (x *RangeDiff) SetNewEndPos(v int32) {
+	x.NewEndPos = v
+}
+
+type RangeDiff_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Kind           RangeDiff_Kind
+	OldDescription string
+	OldStartPos    int32
+	OldEndPos      int32
+	NewDescription string
+	NewStartPos    int32
+	NewEndPos      int32
+}
+
+func (b0 RangeDiff_builder) Build() *RangeDiff {
+	m0 := &RangeDiff{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Kind = b.Kind
+	x.OldDescription = b.OldDescription
+	x.OldStartPos = b.OldStartPos
+	x.OldEndPos = b.OldEndPos
+	x.NewDescription = b.NewDescription
+	x.NewStartPos = b.NewStartPos
+	x.NewEndPos = b.NewEndPos
 	return m0
 }
 
@@ -4921,7 +5568,7 @@ type TranscriptDialog struct {
 
 func (x *TranscriptDialog) Reset() {
 	*x = TranscriptDialog{}
-	mi := &file_transcript_proto_msgTypes[41]
+	mi := &file_transcript_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4933,7 +5580,7 @@ func (x *TranscriptDialog) String() string {
 func (*TranscriptDialog) ProtoMessage() {}
 
 func (x *TranscriptDialog) ProtoReflect() protoreflect.Message {
-	mi := &file_transcript_proto_msgTypes[41]
+	mi := &file_transcript_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5017,7 +5664,7 @@ type SetTranscriptRatingScoreRequest struct {
 
 func (x *SetTranscriptRatingScoreRequest) Reset() {
 	*x = SetTranscriptRatingScoreRequest{}
-	mi := &file_transcript_proto_msgTypes[42]
+	mi := &file_transcript_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5029,7 +5676,7 @@ func (x *SetTranscriptRatingScoreRequest) String() string {
 func (*SetTranscriptRatingScoreRequest) ProtoMessage() {}
 
 func (x *SetTranscriptRatingScoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transcript_proto_msgTypes[42]
+	mi := &file_transcript_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5102,7 +5749,7 @@ type BulkSetTranscriptRatingScoreRequest struct {
 
 func (x *BulkSetTranscriptRatingScoreRequest) Reset() {
 	*x = BulkSetTranscriptRatingScoreRequest{}
-	mi := &file_transcript_proto_msgTypes[43]
+	mi := &file_transcript_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5114,7 +5761,7 @@ func (x *BulkSetTranscriptRatingScoreRequest) String() string {
 func (*BulkSetTranscriptRatingScoreRequest) ProtoMessage() {}
 
 func (x *BulkSetTranscriptRatingScoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transcript_proto_msgTypes[43]
+	mi := &file_transcript_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5186,7 +5833,7 @@ type BulkSetTranscriptTagsRequest struct {
 
 func (x *BulkSetTranscriptTagsRequest) Reset() {
 	*x = BulkSetTranscriptTagsRequest{}
-	mi := &file_transcript_proto_msgTypes[44]
+	mi := &file_transcript_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5198,7 +5845,7 @@ func (x *BulkSetTranscriptTagsRequest) String() string {
 func (*BulkSetTranscriptTagsRequest) ProtoMessage() {}
 
 func (x *BulkSetTranscriptTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transcript_proto_msgTypes[44]
+	mi := &file_transcript_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5257,7 +5904,7 @@ type Tag struct {
 
 func (x *Tag) Reset() {
 	*x = Tag{}
-	mi := &file_transcript_proto_msgTypes[45]
+	mi := &file_transcript_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5269,7 +5916,7 @@ func (x *Tag) String() string {
 func (*Tag) ProtoMessage() {}
 
 func (x *Tag) ProtoReflect() protoreflect.Message {
-	mi := &file_transcript_proto_msgTypes[45]
+	mi := &file_transcript_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5596,7 +6243,7 @@ const file_transcript_proto_rawDesc = "" +
 	"\x1dDeleteTranscriptChangeRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"L\n" +
 	"\x14TranscriptChangeList\x124\n" +
-	"\achanges\x18\x01 \x03(\v2\x1a.rsk.ShortTranscriptChangeR\achanges\"\x98\x03\n" +
+	"\achanges\x18\x01 \x03(\v2\x1a.rsk.ShortTranscriptChangeR\achanges\"\xd9\x03\n" +
 	"\x10TranscriptChange\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -5614,7 +6261,13 @@ const file_transcript_proto_rawDesc = "" +
 	" \x01(\x02R\rpointsAwarded\x12-\n" +
 	"\x12transcript_version\x18\v \x01(\tR\x11transcriptVersion\x12\x12\n" +
 	"\x04name\x18\f \x01(\tR\x04name\x12!\n" +
-	"\frelease_date\x18\r \x01(\tR\vreleaseDateJ\x04\b\x05\x10\x06\"\xa6\x02\n" +
+	"\frelease_date\x18\r \x01(\tR\vreleaseDate\x12?\n" +
+	"\rlint_warnings\x18\x0e \x03(\v2\x1a.rsk.TranscriptLintWarningR\flintWarningsJ\x04\b\x05\x10\x06\"u\n" +
+	"\x15TranscriptLintWarning\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12\x1a\n" +
+	"\bseverity\x18\x02 \x01(\tR\bseverity\x12\x12\n" +
+	"\x04line\x18\x03 \x01(\x05R\x04line\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xa6\x02\n" +
 	"\x15ShortTranscriptChange\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -5634,9 +6287,54 @@ const file_transcript_proto_rawDesc = "" +
 	"\x1aGetTranscriptChangeRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"0\n" +
 	"\x1eGetTranscriptChangeDiffRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\",\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xca\x01\n" +
 	"\x14TranscriptChangeDiff\x12\x14\n" +
-	"\x05diffs\x18\x02 \x03(\tR\x05diffs\"\xa6\x01\n" +
+	"\x05diffs\x18\x02 \x03(\tR\x05diffs\x122\n" +
+	"\fdialog_diffs\x18\x03 \x03(\v2\x0f.rsk.DialogDiffR\vdialogDiffs\x125\n" +
+	"\x0esynopsis_diffs\x18\x04 \x03(\v2\x0e.rsk.RangeDiffR\rsynopsisDiffs\x121\n" +
+	"\ftrivia_diffs\x18\x05 \x03(\v2\x0e.rsk.RangeDiffR\vtriviaDiffs\"\xa0\x02\n" +
+	"\n" +
+	"DialogDiff\x12(\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x14.rsk.DialogDiff.KindR\x04kind\x12*\n" +
+	"\n" +
+	"old_dialog\x18\x02 \x01(\v2\v.rsk.DialogR\toldDialog\x12*\n" +
+	"\n" +
+	"new_dialog\x18\x03 \x01(\v2\v.rsk.DialogR\tnewDialog\x12#\n" +
+	"\x05words\x18\x04 \x03(\v2\r.rsk.WordDiffR\x05words\"k\n" +
+	"\x04Kind\x12\x12\n" +
+	"\x0eKIND_UNDEFINED\x10\x00\x12\n" +
+	"\n" +
+	"\x06INSERT\x10\x01\x12\n" +
+	"\n" +
+	"\x06DELETE\x10\x02\x12\x10\n" +
+	"\fACTOR_CHANGE\x10\x03\x12\x12\n" +
+	"\x0eCONTENT_CHANGE\x10\x04\x12\x11\n" +
+	"\rOFFSET_CHANGE\x10\x05\"i\n" +
+	"\bWordDiff\x12 \n" +
+	"\x02op\x18\x01 \x01(\x0e2\x10.rsk.WordDiff.OpR\x02op\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"'\n" +
+	"\x02Op\x12\t\n" +
+	"\x05EQUAL\x10\x00\x12\n" +
+	"\n" +
+	"\x06INSERT\x10\x01\x12\n" +
+	"\n" +
+	"\x06DELETE\x10\x02\"\xec\x02\n" +
+	"\tRangeDiff\x12'\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x13.rsk.RangeDiff.KindR\x04kind\x12'\n" +
+	"\x0fold_description\x18\x02 \x01(\tR\x0eoldDescription\x12\"\n" +
+	"\rold_start_pos\x18\x03 \x01(\x05R\voldStartPos\x12\x1e\n" +
+	"\vold_end_pos\x18\x04 \x01(\x05R\toldEndPos\x12'\n" +
+	"\x0fnew_description\x18\x05 \x01(\tR\x0enewDescription\x12\"\n" +
+	"\rnew_start_pos\x18\x06 \x01(\x05R\vnewStartPos\x12\x1e\n" +
+	"\vnew_end_pos\x18\a \x01(\x05R\tnewEndPos\"\\\n" +
+	"\x04Kind\x12\x12\n" +
+	"\x0eKIND_UNDEFINED\x10\x00\x12\n" +
+	"\n" +
+	"\x06INSERT\x10\x01\x12\n" +
+	"\n" +
+	"\x06DELETE\x10\x02\x12\x16\n" +
+	"\x12DESCRIPTION_CHANGE\x10\x03\x12\x10\n" +
+	"\fRANGE_CHANGE\x10\x04\"\xa6\x01\n" +
 	"\x10TranscriptDialog\x12=\n" +
 	"\x0ftranscript_meta\x18\x01 \x01(\v2\x14.rsk.ShortTranscriptR\x0etranscriptMeta\x12#\n" +
 	"\x06dialog\x18\x02 \x03(\v2\v.rsk.DialogR\x06dialog\x12.\n" +
@@ -5726,164 +6424,181 @@ const file_transcript_proto_rawDesc = "" +
 	"\x06search\x12%Submits multiple tags for an episode.*\x14bulkSetTranscriptTag\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/api/transcript/{epid}/tag/bulkB\xa2\x01\x92Aq\x12\x052\x031.0*\x01\x01re\n" +
 	"LTranscript service has endpoints related to viewing and editing transcripts.\x12\x15https://scrimpton.comZ,github.com/warmans/rsk-search/server/gen/apib\x06proto3"
 
-var file_transcript_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_transcript_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
+var file_transcript_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_transcript_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_transcript_proto_goTypes = []any{
 	(ContributionState)(0),                       // 0: rsk.ContributionState
 	(AudioQuality)(0),                            // 1: rsk.AudioQuality
 	(PublicationType)(0),                         // 2: rsk.PublicationType
 	(Dialog_DialogType)(0),                       // 3: rsk.Dialog.DialogType
-	(*Transcript)(nil),                           // 4: rsk.Transcript
-	(*Media)(nil),                                // 5: rsk.Media
-	(*ShortTranscript)(nil),                      // 6: rsk.ShortTranscript
-	(*Dialog)(nil),                               // 7: rsk.Dialog
-	(*Synopsis)(nil),                             // 8: rsk.Synopsis
-	(*Trivia)(nil),                               // 9: rsk.Trivia
-	(*GetTranscriptRequest)(nil),                 // 10: rsk.GetTranscriptRequest
-	(*DialogRange)(nil),                          // 11: rsk.DialogRange
-	(*GetTranscriptDialogRequest)(nil),           // 12: rsk.GetTranscriptDialogRequest
-	(*ListTranscriptsRequest)(nil),               // 13: rsk.ListTranscriptsRequest
-	(*TranscriptList)(nil),                       // 14: rsk.TranscriptList
-	(*Ratings)(nil),                              // 15: rsk.Ratings
-	(*ChunkStates)(nil),                          // 16: rsk.ChunkStates
-	(*ChunkedTranscriptStats)(nil),               // 17: rsk.ChunkedTranscriptStats
-	(*ChunkedTranscriptList)(nil),                // 18: rsk.ChunkedTranscriptList
-	(*ChunkStats)(nil),                           // 19: rsk.ChunkStats
-	(*GetTranscriptChunkRequest)(nil),            // 20: rsk.GetTranscriptChunkRequest
-	(*Chunk)(nil),                                // 21: rsk.Chunk
-	(*ListTranscriptChunksRequest)(nil),          // 22: rsk.ListTranscriptChunksRequest
-	(*TranscriptChunkList)(nil),                  // 23: rsk.TranscriptChunkList
-	(*ListChunkContributionsRequest)(nil),        // 24: rsk.ListChunkContributionsRequest
-	(*ChunkContributionList)(nil),                // 25: rsk.ChunkContributionList
-	(*ChunkContribution)(nil),                    // 26: rsk.ChunkContribution
-	(*ShortChunkContribution)(nil),               // 27: rsk.ShortChunkContribution
-	(*ChunkChunkContributionList)(nil),           // 28: rsk.ChunkChunkContributionList
-	(*GetChunkContributionRequest)(nil),          // 29: rsk.GetChunkContributionRequest
-	(*CreateChunkContributionRequest)(nil),       // 30: rsk.CreateChunkContributionRequest
-	(*UpdateChunkContributionRequest)(nil),       // 31: rsk.UpdateChunkContributionRequest
-	(*DeleteChunkContributionRequest)(nil),       // 32: rsk.DeleteChunkContributionRequest
-	(*RequestChunkContributionStateRequest)(nil), // 33: rsk.RequestChunkContributionStateRequest
-	(*CreateTranscriptChangeRequest)(nil),        // 34: rsk.CreateTranscriptChangeRequest
-	(*ListTranscriptChangesRequest)(nil),         // 35: rsk.ListTranscriptChangesRequest
-	(*UpdateTranscriptChangeRequest)(nil),        // 36: rsk.UpdateTranscriptChangeRequest
-	(*DeleteTranscriptChangeRequest)(nil),        // 37: rsk.DeleteTranscriptChangeRequest
-	(*TranscriptChangeList)(nil),                 // 38: rsk.TranscriptChangeList
-	(*TranscriptChange)(nil),                     // 39: rsk.TranscriptChange
-	(*ShortTranscriptChange)(nil),                // 40: rsk.ShortTranscriptChange
-	(*RequestTranscriptChangeStateRequest)(nil),  // 41: rsk.RequestTranscriptChangeStateRequest
-	(*GetTranscriptChangeRequest)(nil),           // 42: rsk.GetTranscriptChangeRequest
-	(*GetTranscriptChangeDiffRequest)(nil),       // 43: rsk.GetTranscriptChangeDiffRequest
-	(*TranscriptChangeDiff)(nil),                 // 44: rsk.TranscriptChangeDiff
-	(*TranscriptDialog)(nil),                     // 45: rsk.TranscriptDialog
-	(*SetTranscriptRatingScoreRequest)(nil),      // 46: rsk.SetTranscriptRatingScoreRequest
-	(*BulkSetTranscriptRatingScoreRequest)(nil),  // 47: rsk.BulkSetTranscriptRatingScoreRequest
-	(*BulkSetTranscriptTagsRequest)(nil),         // 48: rsk.BulkSetTranscriptTagsRequest
-	(*Tag)(nil),                                  // 49: rsk.Tag
-	nil,                                          // 50: rsk.Transcript.MetadataEntry
-	nil,                                          // 51: rsk.ShortTranscript.MetadataEntry
-	nil,                                          // 52: rsk.ShortTranscript.RatingBreakdownEntry
-	nil,                                          // 53: rsk.Dialog.MetadataEntry
-	nil,                                          // 54: rsk.Ratings.ScoresEntry
-	nil,                                          // 55: rsk.ChunkedTranscriptStats.ChunkContributionsEntry
-	nil,                                          // 56: rsk.BulkSetTranscriptRatingScoreRequest.ScoresEntry
-	(*Author)(nil),                               // 57: rsk.Author
-	(*emptypb.Empty)(nil),                        // 58: google.protobuf.Empty
+	(DialogDiff_Kind)(0),                         // 4: rsk.DialogDiff.Kind
+	(WordDiff_Op)(0),                             // 5: rsk.WordDiff.Op
+	(RangeDiff_Kind)(0),                          // 6: rsk.RangeDiff.Kind
+	(*Transcript)(nil),                           // 7: rsk.Transcript
+	(*Media)(nil),                                // 8: rsk.Media
+	(*ShortTranscript)(nil),                      // 9: rsk.ShortTranscript
+	(*Dialog)(nil),                               // 10: rsk.Dialog
+	(*Synopsis)(nil),                             // 11: rsk.Synopsis
+	(*Trivia)(nil),                               // 12: rsk.Trivia
+	(*GetTranscriptRequest)(nil),                 // 13: rsk.GetTranscriptRequest
+	(*DialogRange)(nil),                          // 14: rsk.DialogRange
+	(*GetTranscriptDialogRequest)(nil),           // 15: rsk.GetTranscriptDialogRequest
+	(*ListTranscriptsRequest)(nil),               // 16: rsk.ListTranscriptsRequest
+	(*TranscriptList)(nil),                       // 17: rsk.TranscriptList
+	(*Ratings)(nil),                              // 18: rsk.Ratings
+	(*ChunkStates)(nil),                          // 19: rsk.ChunkStates
+	(*ChunkedTranscriptStats)(nil),               // 20: rsk.ChunkedTranscriptStats
+	(*ChunkedTranscriptList)(nil),                // 21: rsk.ChunkedTranscriptList
+	(*ChunkStats)(nil),                           // 22: rsk.ChunkStats
+	(*GetTranscriptChunkRequest)(nil),            // 23: rsk.GetTranscriptChunkRequest
+	(*Chunk)(nil),                                // 24: rsk.Chunk
+	(*ListTranscriptChunksRequest)(nil),          // 25: rsk.ListTranscriptChunksRequest
+	(*TranscriptChunkList)(nil),                  // 26: rsk.TranscriptChunkList
+	(*ListChunkContributionsRequest)(nil),        // 27: rsk.ListChunkContributionsRequest
+	(*ChunkContributionList)(nil),                // 28: rsk.ChunkContributionList
+	(*ChunkContribution)(nil),                    // 29: rsk.ChunkContribution
+	(*ShortChunkContribution)(nil),               // 30: rsk.ShortChunkContribution
+	(*ChunkChunkContributionList)(nil),           // 31: rsk.ChunkChunkContributionList
+	(*GetChunkContributionRequest)(nil),          // 32: rsk.GetChunkContributionRequest
+	(*CreateChunkContributionRequest)(nil),       // 33: rsk.CreateChunkContributionRequest
+	(*UpdateChunkContributionRequest)(nil),       // 34: rsk.UpdateChunkContributionRequest
+	(*DeleteChunkContributionRequest)(nil),       // 35: rsk.DeleteChunkContributionRequest
+	(*RequestChunkContributionStateRequest)(nil), // 36: rsk.RequestChunkContributionStateRequest
+	(*CreateTranscriptChangeRequest)(nil),        // 37: rsk.CreateTranscriptChangeRequest
+	(*ListTranscriptChangesRequest)(nil),         // 38: rsk.ListTranscriptChangesRequest
+	(*UpdateTranscriptChangeRequest)(nil),        // 39: rsk.UpdateTranscriptChangeRequest
+	(*DeleteTranscriptChangeRequest)(nil),        // 40: rsk.DeleteTranscriptChangeRequest
+	(*TranscriptChangeList)(nil),                 // 41: rsk.TranscriptChangeList
+	(*TranscriptChange)(nil),                     // 42: rsk.TranscriptChange
+	(*TranscriptLintWarning)(nil),                // 43: rsk.TranscriptLintWarning
+	(*ShortTranscriptChange)(nil),                // 44: rsk.ShortTranscriptChange
+	(*RequestTranscriptChangeStateRequest)(nil),  // 45: rsk.RequestTranscriptChangeStateRequest
+	(*GetTranscriptChangeRequest)(nil),           // 46: rsk.GetTranscriptChangeRequest
+	(*GetTranscriptChangeDiffRequest)(nil),       // 47: rsk.GetTranscriptChangeDiffRequest
+	(*TranscriptChangeDiff)(nil),                 // 48: rsk.TranscriptChangeDiff
+	(*DialogDiff)(nil),                           // 49: rsk.DialogDiff
+	(*WordDiff)(nil),                             // 50: rsk.WordDiff
+	(*RangeDiff)(nil),                            // 51: rsk.RangeDiff
+	(*TranscriptDialog)(nil),                     // 52: rsk.TranscriptDialog
+	(*SetTranscriptRatingScoreRequest)(nil),      // 53: rsk.SetTranscriptRatingScoreRequest
+	(*BulkSetTranscriptRatingScoreRequest)(nil),  // 54: rsk.BulkSetTranscriptRatingScoreRequest
+	(*BulkSetTranscriptTagsRequest)(nil),         // 55: rsk.BulkSetTranscriptTagsRequest
+	(*Tag)(nil),                                  // 56: rsk.Tag
+	nil,                                          // 57: rsk.Transcript.MetadataEntry
+	nil,                                          // 58: rsk.ShortTranscript.MetadataEntry
+	nil,                                          // 59: rsk.ShortTranscript.RatingBreakdownEntry
+	nil,                                          // 60: rsk.Dialog.MetadataEntry
+	nil,                                          // 61: rsk.Ratings.ScoresEntry
+	nil,                                          // 62: rsk.ChunkedTranscriptStats.ChunkContributionsEntry
+	nil,                                          // 63: rsk.BulkSetTranscriptRatingScoreRequest.ScoresEntry
+	(*Author)(nil),                               // 64: rsk.Author
+	(*emptypb.Empty)(nil),                        // 65: google.protobuf.Empty
 }
 var file_transcript_proto_depIdxs = []int32{
-	50, // 0: rsk.Transcript.metadata:type_name -> rsk.Transcript.MetadataEntry
-	7,  // 1: rsk.Transcript.transcript:type_name -> rsk.Dialog
-	8,  // 2: rsk.Transcript.synopses:type_name -> rsk.Synopsis
-	9,  // 3: rsk.Transcript.trivia:type_name -> rsk.Trivia
+	57, // 0: rsk.Transcript.metadata:type_name -> rsk.Transcript.MetadataEntry
+	10, // 1: rsk.Transcript.transcript:type_name -> rsk.Dialog
+	11, // 2: rsk.Transcript.synopses:type_name -> rsk.Synopsis
+	12, // 3: rsk.Transcript.trivia:type_name -> rsk.Trivia
 	1,  // 4: rsk.Transcript.audio_quality:type_name -> rsk.AudioQuality
-	5,  // 5: rsk.Transcript.media:type_name -> rsk.Media
+	8,  // 5: rsk.Transcript.media:type_name -> rsk.Media
 	2,  // 6: rsk.Transcript.publication_type:type_name -> rsk.PublicationType
-	15, // 7: rsk.Transcript.ratings:type_name -> rsk.Ratings
-	49, // 8: rsk.Transcript.tags:type_name -> rsk.Tag
-	8,  // 9: rsk.ShortTranscript.synopsis:type_name -> rsk.Synopsis
-	51, // 10: rsk.ShortTranscript.metadata:type_name -> rsk.ShortTranscript.MetadataEntry
+	18, // 7: rsk.Transcript.ratings:type_name -> rsk.Ratings
+	56, // 8: rsk.Transcript.tags:type_name -> rsk.Tag
+	11, // 9: rsk.ShortTranscript.synopsis:type_name -> rsk.Synopsis
+	58, // 10: rsk.ShortTranscript.metadata:type_name -> rsk.ShortTranscript.MetadataEntry
 	1,  // 11: rsk.ShortTranscript.audio_quality:type_name -> rsk.AudioQuality
-	5,  // 12: rsk.ShortTranscript.media:type_name -> rsk.Media
+	8,  // 12: rsk.ShortTranscript.media:type_name -> rsk.Media
 	2,  // 13: rsk.ShortTranscript.publication_type:type_name -> rsk.PublicationType
-	52, // 14: rsk.ShortTranscript.rating_breakdown:type_name -> rsk.ShortTranscript.RatingBreakdownEntry
+	59, // 14: rsk.ShortTranscript.rating_breakdown:type_name -> rsk.ShortTranscript.RatingBreakdownEntry
 	3,  // 15: rsk.Dialog.type:type_name -> rsk.Dialog.DialogType
-	53, // 16: rsk.Dialog.metadata:type_name -> rsk.Dialog.MetadataEntry
-	11, // 17: rsk.GetTranscriptDialogRequest.range:type_name -> rsk.DialogRange
-	6,  // 18: rsk.TranscriptList.episodes:type_name -> rsk.ShortTranscript
-	54, // 19: rsk.Ratings.scores:type_name -> rsk.Ratings.ScoresEntry
+	60, // 16: rsk.Dialog.metadata:type_name -> rsk.Dialog.MetadataEntry
+	14, // 17: rsk.GetTranscriptDialogRequest.range:type_name -> rsk.DialogRange
+	9,  // 18: rsk.TranscriptList.episodes:type_name -> rsk.ShortTranscript
+	61, // 19: rsk.Ratings.scores:type_name -> rsk.Ratings.ScoresEntry
 	0,  // 20: rsk.ChunkStates.states:type_name -> rsk.ContributionState
-	55, // 21: rsk.ChunkedTranscriptStats.chunk_contributions:type_name -> rsk.ChunkedTranscriptStats.ChunkContributionsEntry
-	17, // 22: rsk.ChunkedTranscriptList.chunked:type_name -> rsk.ChunkedTranscriptStats
-	21, // 23: rsk.TranscriptChunkList.chunks:type_name -> rsk.Chunk
-	26, // 24: rsk.ChunkContributionList.contributions:type_name -> rsk.ChunkContribution
+	62, // 21: rsk.ChunkedTranscriptStats.chunk_contributions:type_name -> rsk.ChunkedTranscriptStats.ChunkContributionsEntry
+	20, // 22: rsk.ChunkedTranscriptList.chunked:type_name -> rsk.ChunkedTranscriptStats
+	24, // 23: rsk.TranscriptChunkList.chunks:type_name -> rsk.Chunk
+	29, // 24: rsk.ChunkContributionList.contributions:type_name -> rsk.ChunkContribution
 	0,  // 25: rsk.ChunkContribution.state:type_name -> rsk.ContributionState
-	57, // 26: rsk.ChunkContribution.author:type_name -> rsk.Author
+	64, // 26: rsk.ChunkContribution.author:type_name -> rsk.Author
 	0,  // 27: rsk.ShortChunkContribution.state:type_name -> rsk.ContributionState
-	27, // 28: rsk.ChunkChunkContributionList.contributions:type_name -> rsk.ShortChunkContribution
+	30, // 28: rsk.ChunkChunkContributionList.contributions:type_name -> rsk.ShortChunkContribution
 	0,  // 29: rsk.UpdateChunkContributionRequest.state:type_name -> rsk.ContributionState
 	0,  // 30: rsk.RequestChunkContributionStateRequest.request_state:type_name -> rsk.ContributionState
 	0,  // 31: rsk.UpdateTranscriptChangeRequest.state:type_name -> rsk.ContributionState
-	40, // 32: rsk.TranscriptChangeList.changes:type_name -> rsk.ShortTranscriptChange
+	44, // 32: rsk.TranscriptChangeList.changes:type_name -> rsk.ShortTranscriptChange
 	0,  // 33: rsk.TranscriptChange.state:type_name -> rsk.ContributionState
-	57, // 34: rsk.TranscriptChange.author:type_name -> rsk.Author
-	0,  // 35: rsk.ShortTranscriptChange.state:type_name -> rsk.ContributionState
-	57, // 36: rsk.ShortTranscriptChange.author:type_name -> rsk.Author
-	0,  // 37: rsk.RequestTranscriptChangeStateRequest.state:type_name -> rsk.ContributionState
-	6,  // 38: rsk.TranscriptDialog.transcript_meta:type_name -> rsk.ShortTranscript
-	7,  // 39: rsk.TranscriptDialog.dialog:type_name -> rsk.Dialog
-	56, // 40: rsk.BulkSetTranscriptRatingScoreRequest.scores:type_name -> rsk.BulkSetTranscriptRatingScoreRequest.ScoresEntry
-	49, // 41: rsk.BulkSetTranscriptTagsRequest.tags:type_name -> rsk.Tag
-	16, // 42: rsk.ChunkedTranscriptStats.ChunkContributionsEntry.value:type_name -> rsk.ChunkStates
-	10, // 43: rsk.TranscriptService.GetTranscript:input_type -> rsk.GetTranscriptRequest
-	12, // 44: rsk.TranscriptService.GetTranscriptDialog:input_type -> rsk.GetTranscriptDialogRequest
-	13, // 45: rsk.TranscriptService.ListTranscripts:input_type -> rsk.ListTranscriptsRequest
-	58, // 46: rsk.TranscriptService.ListChunkedTranscripts:input_type -> google.protobuf.Empty
-	58, // 47: rsk.TranscriptService.GetChunkedTranscriptChunkStats:input_type -> google.protobuf.Empty
-	22, // 48: rsk.TranscriptService.ListTranscriptChunks:input_type -> rsk.ListTranscriptChunksRequest
-	20, // 49: rsk.TranscriptService.GetTranscriptChunk:input_type -> rsk.GetTranscriptChunkRequest
-	24, // 50: rsk.TranscriptService.ListChunkContributions:input_type -> rsk.ListChunkContributionsRequest
-	30, // 51: rsk.TranscriptService.CreateChunkContribution:input_type -> rsk.CreateChunkContributionRequest
-	29, // 52: rsk.TranscriptService.GetChunkContribution:input_type -> rsk.GetChunkContributionRequest
-	31, // 53: rsk.TranscriptService.UpdateChunkContribution:input_type -> rsk.UpdateChunkContributionRequest
-	32, // 54: rsk.TranscriptService.DeleteChunkContribution:input_type -> rsk.DeleteChunkContributionRequest
-	33, // 55: rsk.TranscriptService.RequestChunkContributionState:input_type -> rsk.RequestChunkContributionStateRequest
-	35, // 56: rsk.TranscriptService.ListTranscriptChanges:input_type -> rsk.ListTranscriptChangesRequest
-	42, // 57: rsk.TranscriptService.GetTranscriptChange:input_type -> rsk.GetTranscriptChangeRequest
-	43, // 58: rsk.TranscriptService.GetTranscriptChangeDiff:input_type -> rsk.GetTranscriptChangeDiffRequest
-	34, // 59: rsk.TranscriptService.CreateTranscriptChange:input_type -> rsk.CreateTranscriptChangeRequest
-	36, // 60: rsk.TranscriptService.UpdateTranscriptChange:input_type -> rsk.UpdateTranscriptChangeRequest
-	37, // 61: rsk.TranscriptService.DeleteTranscriptChange:input_type -> rsk.DeleteTranscriptChangeRequest
-	41, // 62: rsk.TranscriptService.RequestTranscriptChangeState:input_type -> rsk.RequestTranscriptChangeStateRequest
-	46, // 63: rsk.TranscriptService.SetTranscriptRatingScore:input_type -> rsk.SetTranscriptRatingScoreRequest
-	47, // 64: rsk.TranscriptService.BulkSetTranscriptRatingScore:input_type -> rsk.BulkSetTranscriptRatingScoreRequest
-	48, // 65: rsk.TranscriptService.BulkSetTranscriptTags:input_type -> rsk.BulkSetTranscriptTagsRequest
-	4,  // 66: rsk.TranscriptService.GetTranscript:output_type -> rsk.Transcript
-	45, // 67: rsk.TranscriptService.GetTranscriptDialog:output_type -> rsk.TranscriptDialog
-	14, // 68: rsk.TranscriptService.ListTranscripts:output_type -> rsk.TranscriptList
-	18, // 69: rsk.TranscriptService.ListChunkedTranscripts:output_type -> rsk.ChunkedTranscriptList
-	19, // 70: rsk.TranscriptService.GetChunkedTranscriptChunkStats:output_type -> rsk.ChunkStats
-	23, // 71: rsk.TranscriptService.ListTranscriptChunks:output_type -> rsk.TranscriptChunkList
-	21, // 72: rsk.TranscriptService.GetTranscriptChunk:output_type -> rsk.Chunk
-	25, // 73: rsk.TranscriptService.ListChunkContributions:output_type -> rsk.ChunkContributionList
-	26, // 74: rsk.TranscriptService.CreateChunkContribution:output_type -> rsk.ChunkContribution
-	26, // 75: rsk.TranscriptService.GetChunkContribution:output_type -> rsk.ChunkContribution
-	26, // 76: rsk.TranscriptService.UpdateChunkContribution:output_type -> rsk.ChunkContribution
-	58, // 77: rsk.TranscriptService.DeleteChunkContribution:output_type -> google.protobuf.Empty
-	26, // 78: rsk.TranscriptService.RequestChunkContributionState:output_type -> rsk.ChunkContribution
-	38, // 79: rsk.TranscriptService.ListTranscriptChanges:output_type -> rsk.TranscriptChangeList
-	39, // 80: rsk.TranscriptService.GetTranscriptChange:output_type -> rsk.TranscriptChange
-	44, // 81: rsk.TranscriptService.GetTranscriptChangeDiff:output_type -> rsk.TranscriptChangeDiff
-	39, // 82: rsk.TranscriptService.CreateTranscriptChange:output_type -> rsk.TranscriptChange
-	39, // 83: rsk.TranscriptService.UpdateTranscriptChange:output_type -> rsk.TranscriptChange
-	58, // 84: rsk.TranscriptService.DeleteTranscriptChange:output_type -> google.protobuf.Empty
-	58, // 85: rsk.TranscriptService.RequestTranscriptChangeState:output_type -> google.protobuf.Empty
-	58, // 86: rsk.TranscriptService.SetTranscriptRatingScore:output_type -> google.protobuf.Empty
-	58, // 87: rsk.TranscriptService.BulkSetTranscriptRatingScore:output_type -> google.protobuf.Empty
-	58, // 88: rsk.TranscriptService.BulkSetTranscriptTags:output_type -> google.protobuf.Empty
-	66, // [66:89] is the sub-list for method output_type
-	43, // [43:66] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	64, // 34: rsk.TranscriptChange.author:type_name -> rsk.Author
+	43, // 35: rsk.TranscriptChange.lint_warnings:type_name -> rsk.TranscriptLintWarning
+	0,  // 36: rsk.ShortTranscriptChange.state:type_name -> rsk.ContributionState
+	64, // 37: rsk.ShortTranscriptChange.author:type_name -> rsk.Author
+	0,  // 38: rsk.RequestTranscriptChangeStateRequest.state:type_name -> rsk.ContributionState
+	49, // 39: rsk.TranscriptChangeDiff.dialog_diffs:type_name -> rsk.DialogDiff
+	51, // 40: rsk.TranscriptChangeDiff.synopsis_diffs:type_name -> rsk.RangeDiff
+	51, // 41: rsk.TranscriptChangeDiff.trivia_diffs:type_name -> rsk.RangeDiff
+	4,  // 42: rsk.DialogDiff.kind:type_name -> rsk.DialogDiff.Kind
+	10, // 43: rsk.DialogDiff.old_dialog:type_name -> rsk.Dialog
+	10, // 44: rsk.DialogDiff.new_dialog:type_name -> rsk.Dialog
+	50, // 45: rsk.DialogDiff.words:type_name -> rsk.WordDiff
+	5,  // 46: rsk.WordDiff.op:type_name -> rsk.WordDiff.Op
+	6,  // 47: rsk.RangeDiff.kind:type_name -> rsk.RangeDiff.Kind
+	9,  // 48: rsk.TranscriptDialog.transcript_meta:type_name -> rsk.ShortTranscript
+	10, // 49: rsk.TranscriptDialog.dialog:type_name -> rsk.Dialog
+	63, // 50: rsk.BulkSetTranscriptRatingScoreRequest.scores:type_name -> rsk.BulkSetTranscriptRatingScoreRequest.ScoresEntry
+	56, // 51: rsk.BulkSetTranscriptTagsRequest.tags:type_name -> rsk.Tag
+	19, // 52: rsk.ChunkedTranscriptStats.ChunkContributionsEntry.value:type_name -> rsk.ChunkStates
+	13, // 53: rsk.TranscriptService.GetTranscript:input_type -> rsk.GetTranscriptRequest
+	15, // 54: rsk.TranscriptService.GetTranscriptDialog:input_type -> rsk.GetTranscriptDialogRequest
+	16, // 55: rsk.TranscriptService.ListTranscripts:input_type -> rsk.ListTranscriptsRequest
+	65, // 56: rsk.TranscriptService.ListChunkedTranscripts:input_type -> google.protobuf.Empty
+	65, // 57: rsk.TranscriptService.GetChunkedTranscriptChunkStats:input_type -> google.protobuf.Empty
+	25, // 58: rsk.TranscriptService.ListTranscriptChunks:input_type -> rsk.ListTranscriptChunksRequest
+	23, // 59: rsk.TranscriptService.GetTranscriptChunk:input_type -> rsk.GetTranscriptChunkRequest
+	27, // 60: rsk.TranscriptService.ListChunkContributions:input_type -> rsk.ListChunkContributionsRequest
+	33, // 61: rsk.TranscriptService.CreateChunkContribution:input_type -> rsk.CreateChunkContributionRequest
+	32, // 62: rsk.TranscriptService.GetChunkContribution:input_type -> rsk.GetChunkContributionRequest
+	34, // 63: rsk.TranscriptService.UpdateChunkContribution:input_type -> rsk.UpdateChunkContributionRequest
+	35, // 64: rsk.TranscriptService.DeleteChunkContribution:input_type -> rsk.DeleteChunkContributionRequest
+	36, // 65: rsk.TranscriptService.RequestChunkContributionState:input_type -> rsk.RequestChunkContributionStateRequest
+	38, // 66: rsk.TranscriptService.ListTranscriptChanges:input_type -> rsk.ListTranscriptChangesRequest
+	46, // 67: rsk.TranscriptService.GetTranscriptChange:input_type -> rsk.GetTranscriptChangeRequest
+	47, // 68: rsk.TranscriptService.GetTranscriptChangeDiff:input_type -> rsk.GetTranscriptChangeDiffRequest
+	37, // 69: rsk.TranscriptService.CreateTranscriptChange:input_type -> rsk.CreateTranscriptChangeRequest
+	39, // 70: rsk.TranscriptService.UpdateTranscriptChange:input_type -> rsk.UpdateTranscriptChangeRequest
+	40, // 71: rsk.TranscriptService.DeleteTranscriptChange:input_type -> rsk.DeleteTranscriptChangeRequest
+	45, // 72: rsk.TranscriptService.RequestTranscriptChangeState:input_type -> rsk.RequestTranscriptChangeStateRequest
+	53, // 73: rsk.TranscriptService.SetTranscriptRatingScore:input_type -> rsk.SetTranscriptRatingScoreRequest
+	54, // 74: rsk.TranscriptService.BulkSetTranscriptRatingScore:input_type -> rsk.BulkSetTranscriptRatingScoreRequest
+	55, // 75: rsk.TranscriptService.BulkSetTranscriptTags:input_type -> rsk.BulkSetTranscriptTagsRequest
+	7,  // 76: rsk.TranscriptService.GetTranscript:output_type -> rsk.Transcript
+	52, // 77: rsk.TranscriptService.GetTranscriptDialog:output_type -> rsk.TranscriptDialog
+	17, // 78: rsk.TranscriptService.ListTranscripts:output_type -> rsk.TranscriptList
+	21, // 79: rsk.TranscriptService.ListChunkedTranscripts:output_type -> rsk.ChunkedTranscriptList
+	22, // 80: rsk.TranscriptService.GetChunkedTranscriptChunkStats:output_type -> rsk.ChunkStats
+	26, // 81: rsk.TranscriptService.ListTranscriptChunks:output_type -> rsk.TranscriptChunkList
+	24, // 82: rsk.TranscriptService.GetTranscriptChunk:output_type -> rsk.Chunk
+	28, // 83: rsk.TranscriptService.ListChunkContributions:output_type -> rsk.ChunkContributionList
+	29, // 84: rsk.TranscriptService.CreateChunkContribution:output_type -> rsk.ChunkContribution
+	29, // 85: rsk.TranscriptService.GetChunkContribution:output_type -> rsk.ChunkContribution
+	29, // 86: rsk.TranscriptService.UpdateChunkContribution:output_type -> rsk.ChunkContribution
+	65, // 87: rsk.TranscriptService.DeleteChunkContribution:output_type -> google.protobuf.Empty
+	29, // 88: rsk.TranscriptService.RequestChunkContributionState:output_type -> rsk.ChunkContribution
+	41, // 89: rsk.TranscriptService.ListTranscriptChanges:output_type -> rsk.TranscriptChangeList
+	42, // 90: rsk.TranscriptService.GetTranscriptChange:output_type -> rsk.TranscriptChange
+	48, // 91: rsk.TranscriptService.GetTranscriptChangeDiff:output_type -> rsk.TranscriptChangeDiff
+	42, // 92: rsk.TranscriptService.CreateTranscriptChange:output_type -> rsk.TranscriptChange
+	42, // 93: rsk.TranscriptService.UpdateTranscriptChange:output_type -> rsk.TranscriptChange
+	65, // 94: rsk.TranscriptService.DeleteTranscriptChange:output_type -> google.protobuf.Empty
+	65, // 95: rsk.TranscriptService.RequestTranscriptChangeState:output_type -> google.protobuf.Empty
+	65, // 96: rsk.TranscriptService.SetTranscriptRatingScore:output_type -> google.protobuf.Empty
+	65, // 97: rsk.TranscriptService.BulkSetTranscriptRatingScore:output_type -> google.protobuf.Empty
+	65, // 98: rsk.TranscriptService.BulkSetTranscriptTags:output_type -> google.protobuf.Empty
+	76, // [76:99] is the sub-list for method output_type
+	53, // [53:76] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_transcript_proto_init() }
@@ -5899,8 +6614,8 @@ func file_transcript_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_transcript_proto_rawDesc), len(file_transcript_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   53,
+			NumEnums:      7,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
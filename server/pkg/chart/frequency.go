@@ -0,0 +1,106 @@
+package chart
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+
+	"github.com/fogleman/gg"
+	"github.com/golang/freetype/truetype"
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+	"github.com/warmans/rsk-search/gen/api"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+var seriesColors = []color.RGBA{
+	{R: 31, G: 119, B: 180, A: 255},
+	{R: 255, G: 127, B: 14, A: 255},
+	{R: 44, G: 160, B: 44, A: 255},
+	{R: 214, G: 39, B: 40, A: 255},
+	{R: 148, G: 103, B: 189, A: 255},
+	{R: 140, G: 86, B: 75, A: 255},
+	{R: 227, G: 119, B: 194, A: 255},
+	{R: 127, G: 127, B: 127, A: 255},
+}
+
+// GenerateTermFrequencyChart plots a line per term of how often it was said per 10,000 words in each episode.
+func GenerateTermFrequencyChart(
+	ctx context.Context,
+	client api.SearchServiceClient,
+	terms []string,
+	byActor bool,
+) (*gg.Context, error) {
+
+	frequencies, err := client.GetTermFrequency(ctx, &api.GetTermFrequencyRequest{Terms: terms, ByActor: byActor})
+	if err != nil {
+		return nil, err
+	}
+	if byActor && len(frequencies.Series) > len(seriesColors) {
+		frequencies.Series = frequencies.Series[:len(seriesColors)]
+	}
+
+	font, err := truetype.Parse(goregular.TTF)
+	if err != nil {
+		return nil, err
+	}
+
+	face := truetype.NewFace(font, &truetype.Options{Size: 10})
+	legendFace := truetype.NewFace(font, &truetype.Options{Size: 14})
+
+	canvas := gg.NewContext(2000, 600)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	// the scale must fit the highest value of any series
+	maxValues := make([]float64, len(frequencies.EpisodeIds))
+	maxValue := 0.0
+	for _, ser := range frequencies.Series {
+		for k, v := range ser.Frequencies {
+			maxValues[k] = max(maxValues[k], float64(v))
+			maxValue = max(maxValue, float64(v))
+		}
+	}
+	var yScale gochart.YScale = gochart.NewFixedYScale(10, 1)
+	if maxValue > 0 {
+		yScale = gochart.NewYScale(10, gochart.NewXYSeries(frequencies.EpisodeIds, maxValues))
+	}
+	xScale := gochart.NewXScaleFromLabels(frequencies.EpisodeIds)
+
+	plots := []gochart.Plot{gochart.NewYGrid(yScale)}
+	for k, ser := range frequencies.Series {
+		values := make([]float64, len(ser.Frequencies))
+		for i, v := range ser.Frequencies {
+			values[i] = float64(v)
+		}
+		plots = append(plots, gochart.NewLinesPlot(
+			yScale,
+			xScale,
+			gochart.NewXYSeries(frequencies.EpisodeIds, values),
+			gochart.PlotStyle(style.Color(seriesColors[k%len(seriesColors)])),
+		))
+	}
+
+	layout := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(yScale),
+		gochart.NewCompactXAxis(frequencies.EpisodeIds, xScale, gochart.XCompactFontStyles(style.FontFace(face))),
+		plots...,
+	)
+	if err := layout.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		return nil, err
+	}
+
+	// legend
+	canvas.SetFontFace(legendFace)
+	for k, ser := range frequencies.Series {
+		label := fmt.Sprintf("%q", ser.Term)
+		if ser.Actor != "" {
+			label = fmt.Sprintf("%s (%s)", label, ser.Actor)
+		}
+		canvas.SetColor(seriesColors[k%len(seriesColors)])
+		canvas.DrawString(label, 60, float64(20+k*18))
+	}
+
+	return canvas, nil
+}
@@ -14,6 +14,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 func ReplaceEpisodeFile(dataDir string, ep *models.Transcript) error {
@@ -133,6 +134,7 @@ type EpisodeCache struct {
 	episodeList []models.Transcript
 	quoteList   []Quote
 	lock        sync.RWMutex
+	generation  atomic.Uint64
 }
 
 func (s *EpisodeCache) GetEpisode(id string, deepCopy bool) (*models.Transcript, error) {
@@ -159,11 +161,11 @@ func (s *EpisodeCache) GetEpisode(id string, deepCopy bool) (*models.Transcript,
 }
 
 func (s *EpisodeCache) ListEpisodes() ([]*models.Transcript, error) {
-	// copy the episodeList when fetched to avoid unexpected modifications.
-	list := make([]*models.Transcript, len(s.episodeList))
-
 	s.lock.RLock()
 	defer s.lock.RUnlock()
+
+	// copy the episodeList when fetched to avoid unexpected modifications.
+	list := make([]*models.Transcript, len(s.episodeList))
 	for k, v := range s.episodeList {
 		// we will leak references here, but doing a deep copy is really slow.
 		list[k] = transcriptP(v)
@@ -172,9 +174,29 @@ func (s *EpisodeCache) ListEpisodes() ([]*models.Transcript, error) {
 }
 
 func (s *EpisodeCache) RandomQuote() Quote {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 	return s.quoteList[rand.Intn(len(s.quoteList)-1)]
 }
 
+// Swap replaces the cached episodes with those from other.
+func (s *EpisodeCache) Swap(other *EpisodeCache) {
+	other.lock.RLock()
+	defer other.lock.RUnlock()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.episodeMap = other.episodeMap
+	s.episodeList = other.episodeList
+	s.quoteList = other.quoteList
+	s.generation.Add(1)
+}
+
+// Generation changes each time the episodes are swapped so values derived from them can be cached.
+func (s *EpisodeCache) Generation() uint64 {
+	return s.generation.Load()
+}
+
 func transcriptP(transcript models.Transcript) *models.Transcript {
 	return &transcript
 }
@@ -0,0 +1,392 @@
+package diff
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/models"
+)
+
+const (
+	// minLineSimilarity is how similar the words of two lines must be for one to be considered an edit of the
+	// other rather than a different line.
+	minLineSimilarity = 0.5
+	// maxPairingCells limits the size of a changed block that will be searched for edited lines. Larger blocks
+	// are reported as deletes and inserts.
+	maxPairingCells = 40000
+)
+
+// Transcripts compares the dialog, synopses and trivia of two versions of a transcript. Lines are aligned so
+// that edits to a line are reported as changes to the line rather than a delete and insert.
+func Transcripts(oldTs *models.Transcript, newTs *models.Transcript) *api.TranscriptChangeDiff {
+	dialogDiffs, positions := Dialog(oldTs.Transcript, newTs.Transcript)
+	return &api.TranscriptChangeDiff{
+		DialogDiffs:   dialogDiffs,
+		SynopsisDiffs: Ranges(synopsisRanges(oldTs.Synopsis), synopsisRanges(newTs.Synopsis), positions),
+		TriviaDiffs:   Ranges(triviaRanges(oldTs.Trivia), triviaRanges(newTs.Trivia), positions),
+	}
+}
+
+// Dialog returns the changes to the dialog, and where each of the old lines is in the new dialog.
+func Dialog(oldDialog []models.Dialog, newDialog []models.Dialog) ([]*api.DialogDiff, PositionMap) {
+	diffs := []*api.DialogDiff{}
+	positions := PositionMap{mapped: map[int64]int64{}, oldDialog: oldDialog, newDialog: newDialog}
+	for _, l := range align(oldDialog, newDialog) {
+		switch {
+		case l.newIdx == -1:
+			diffs = append(diffs, &api.DialogDiff{Kind: api.DialogDiff_DELETE, OldDialog: oldDialog[l.oldIdx].Proto(false)})
+		case l.oldIdx == -1:
+			diffs = append(diffs, &api.DialogDiff{Kind: api.DialogDiff_INSERT, NewDialog: newDialog[l.newIdx].Proto(false)})
+		default:
+			positions.mapped[oldDialog[l.oldIdx].Position] = newDialog[l.newIdx].Position
+			diffs = append(diffs, lineDiffs(oldDialog[l.oldIdx], newDialog[l.newIdx])...)
+		}
+	}
+	return diffs, positions
+}
+
+// alignedLine is the index of a line in the old and new dialog. The old index is -1 for inserted lines and
+// the new index is -1 for deleted lines.
+type alignedLine struct {
+	oldIdx int
+	newIdx int
+}
+
+// align matches up the old and new lines. Lines that were edited are matched if they are similar enough.
+func align(oldDialog []models.Dialog, newDialog []models.Dialog) []alignedLine {
+	lines := []alignedLine{}
+	matcher := difflib.NewMatcherWithJunk(dialogKeys(oldDialog), dialogKeys(newDialog), false, nil)
+	for _, op := range matcher.GetOpCodes() {
+		switch op.Tag {
+		case 'e':
+			for k := range op.I2 - op.I1 {
+				lines = append(lines, alignedLine{oldIdx: op.I1 + k, newIdx: op.J1 + k})
+			}
+		case 'd':
+			for i := op.I1; i < op.I2; i++ {
+				lines = append(lines, alignedLine{oldIdx: i, newIdx: -1})
+			}
+		case 'i':
+			for j := op.J1; j < op.J2; j++ {
+				lines = append(lines, alignedLine{oldIdx: -1, newIdx: j})
+			}
+		case 'r':
+			i, j := op.I1, op.J1
+			for _, p := range pairLines(oldDialog[op.I1:op.I2], newDialog[op.J1:op.J2]) {
+				for ; i < op.I1+p[0]; i++ {
+					lines = append(lines, alignedLine{oldIdx: i, newIdx: -1})
+				}
+				for ; j < op.J1+p[1]; j++ {
+					lines = append(lines, alignedLine{oldIdx: -1, newIdx: j})
+				}
+				lines = append(lines, alignedLine{oldIdx: i, newIdx: j})
+				i, j = i+1, j+1
+			}
+			for ; i < op.I2; i++ {
+				lines = append(lines, alignedLine{oldIdx: i, newIdx: -1})
+			}
+			for ; j < op.J2; j++ {
+				lines = append(lines, alignedLine{oldIdx: -1, newIdx: j})
+			}
+		}
+	}
+	return lines
+}
+
+// lineDiffs returns the changes between two versions of the same line.
+func lineDiffs(o models.Dialog, n models.Dialog) []*api.DialogDiff {
+	diffs := []*api.DialogDiff{}
+	if actorLabel(o) != actorLabel(n) {
+		diffs = append(diffs, &api.DialogDiff{Kind: api.DialogDiff_ACTOR_CHANGE, OldDialog: o.Proto(false), NewDialog: n.Proto(false)})
+	}
+	if o.Content != n.Content || o.Notable != n.Notable || (o.Type == models.DialogTypeGap && o.Duration != n.Duration) {
+		diffs = append(diffs, &api.DialogDiff{Kind: api.DialogDiff_CONTENT_CHANGE, OldDialog: o.Proto(false), NewDialog: n.Proto(false), Words: Words(o.Content, n.Content)})
+	}
+	if explicitOffset(o) != explicitOffset(n) {
+		diffs = append(diffs, &api.DialogDiff{Kind: api.DialogDiff_OFFSET_CHANGE, OldDialog: o.Proto(false), NewDialog: n.Proto(false)})
+	}
+	return diffs
+}
+
+// pairLines finds the lines in a changed block that are edits of each other. Pairs are the index of the old
+// and new lines and are in order.
+func pairLines(oldBlock []models.Dialog, newBlock []models.Dialog) [][2]int {
+	if len(oldBlock)*len(newBlock) > maxPairingCells {
+		return nil
+	}
+	oldWords, newWords := make([][]string, len(oldBlock)), make([][]string, len(newBlock))
+	oldCounts, newCounts := make([]map[string]int, len(oldBlock)), make([]map[string]int, len(newBlock))
+	for k, d := range oldBlock {
+		oldWords[k] = strings.Fields(strings.ToLower(d.Content))
+		oldCounts[k] = wordCounts(oldWords[k])
+	}
+	for k, d := range newBlock {
+		newWords[k] = strings.Fields(strings.ToLower(d.Content))
+		newCounts[k] = wordCounts(newWords[k])
+	}
+
+	// scores[i][j] is the best total similarity of pairs in oldBlock[i:] and newBlock[j:].
+	scores := make([][]float64, len(oldBlock)+1)
+	for i := range scores {
+		scores[i] = make([]float64, len(newBlock)+1)
+	}
+	similarity := func(i, j int) float64 {
+		if (oldBlock[i].Type == models.DialogTypeGap) != (newBlock[j].Type == models.DialogTypeGap) {
+			return 0
+		}
+		if oldBlock[i].Content == newBlock[j].Content {
+			// only the actor, offset or notability changed.
+			return 1
+		}
+		// the ratio can't be higher than if every shared word matched so most lines can be ruled out cheaply.
+		if maxRatio(oldCounts[i], newCounts[j], len(oldWords[i])+len(newWords[j])) < minLineSimilarity {
+			return 0
+		}
+		if sim := difflib.NewMatcherWithJunk(oldWords[i], newWords[j], false, nil).Ratio(); sim >= minLineSimilarity {
+			return sim
+		}
+		return 0
+	}
+	sims := make([][]float64, len(oldBlock))
+	for i := len(oldBlock) - 1; i >= 0; i-- {
+		sims[i] = make([]float64, len(newBlock))
+		for j := len(newBlock) - 1; j >= 0; j-- {
+			sims[i][j] = similarity(i, j)
+			best := max(scores[i+1][j], scores[i][j+1])
+			if sims[i][j] > 0 {
+				best = max(best, scores[i+1][j+1]+sims[i][j])
+			}
+			scores[i][j] = best
+		}
+	}
+	pairs := [][2]int{}
+	for i, j := 0, 0; i < len(oldBlock) && j < len(newBlock); {
+		switch {
+		case sims[i][j] > 0 && scores[i][j] == scores[i+1][j+1]+sims[i][j]:
+			pairs = append(pairs, [2]int{i, j})
+			i, j = i+1, j+1
+		case scores[i][j] == scores[i+1][j]:
+			i++
+		default:
+			j++
+		}
+	}
+	return pairs
+}
+
+func wordCounts(words []string) map[string]int {
+	counts := make(map[string]int, len(words))
+	for _, w := range words {
+		counts[w]++
+	}
+	return counts
+}
+
+// maxRatio is the upper bound of the similarity ratio of two lines with the given word counts.
+func maxRatio(a map[string]int, b map[string]int, total int) float64 {
+	if total == 0 {
+		return 1
+	}
+	shared := 0
+	for w, n := range a {
+		shared += min(n, b[w])
+	}
+	return 2 * float64(shared) / float64(total)
+}
+
+// Words returns the word level changes between two versions of some content.
+func Words(oldContent string, newContent string) []*api.WordDiff {
+	oldWords, newWords := strings.Fields(oldContent), strings.Fields(newContent)
+	diffs := []*api.WordDiff{}
+	add := func(op api.WordDiff_Op, words []string) {
+		if len(words) == 0 {
+			return
+		}
+		text := strings.Join(words, " ")
+		if len(diffs) > 0 && diffs[len(diffs)-1].Op == op {
+			diffs[len(diffs)-1].Text += " " + text
+			return
+		}
+		diffs = append(diffs, &api.WordDiff{Op: op, Text: text})
+	}
+	for _, op := range difflib.NewMatcherWithJunk(oldWords, newWords, false, nil).GetOpCodes() {
+		switch op.Tag {
+		case 'e':
+			add(api.WordDiff_EQUAL, oldWords[op.I1:op.I2])
+		case 'd':
+			add(api.WordDiff_DELETE, oldWords[op.I1:op.I2])
+		case 'i':
+			add(api.WordDiff_INSERT, newWords[op.J1:op.J2])
+		case 'r':
+			add(api.WordDiff_DELETE, oldWords[op.I1:op.I2])
+			add(api.WordDiff_INSERT, newWords[op.J1:op.J2])
+		}
+	}
+	return diffs
+}
+
+// PositionMap maps the positions of lines in the old transcript to the new transcript.
+type PositionMap struct {
+	mapped    map[int64]int64
+	oldDialog []models.Dialog
+	newDialog []models.Dialog
+}
+
+// Get returns the new position of the old line. If the line was deleted the position of the next line that
+// still exists is used. Positions after the last line are mapped to after the last new line.
+func (p PositionMap) Get(oldPos int64) int64 {
+	for _, d := range p.oldDialog {
+		if d.Position < oldPos {
+			continue
+		}
+		if newPos, ok := p.mapped[d.Position]; ok {
+			return newPos
+		}
+	}
+	if len(p.newDialog) == 0 {
+		return 0
+	}
+	return p.newDialog[len(p.newDialog)-1].Position + 1
+}
+
+// Range is a synopsis or trivia.
+type Range struct {
+	Description string
+	StartPos    int64
+	EndPos      int64
+}
+
+func synopsisRanges(synopses []models.Synopsis) []Range {
+	out := make([]Range, len(synopses))
+	for k, v := range synopses {
+		out[k] = Range{Description: v.Description, StartPos: v.StartPos, EndPos: v.EndPos}
+	}
+	return out
+}
+
+func triviaRanges(trivia []models.Trivia) []Range {
+	out := make([]Range, len(trivia))
+	for k, v := range trivia {
+		out[k] = Range{Description: v.Description, StartPos: v.StartPos, EndPos: v.EndPos}
+	}
+	return out
+}
+
+// Ranges returns the changes to synopses or trivia. Ranges with the same description are matched first, then
+// ranges that cover the same lines are considered to be edits of each other. A range is only reported as
+// changed if it covers different lines after accounting for dialog that was inserted or deleted.
+func Ranges(oldRanges []Range, newRanges []Range, positions PositionMap) []*api.RangeDiff {
+	diffs := []*api.RangeDiff{}
+	newMatched := make([]bool, len(newRanges))
+	oldMatched := make([]bool, len(oldRanges))
+
+	mapped := func(r Range) (int64, int64) {
+		return positions.Get(r.StartPos), positions.Get(r.EndPos)
+	}
+	match := func(oldIdx int, newIdx int) {
+		oldMatched[oldIdx], newMatched[newIdx] = true, true
+		o, n := oldRanges[oldIdx], newRanges[newIdx]
+		start, end := mapped(o)
+		switch {
+		case o.Description != n.Description:
+			diffs = append(diffs, rangeDiff(api.RangeDiff_DESCRIPTION_CHANGE, &o, &n))
+		case start != n.StartPos || end != n.EndPos:
+			diffs = append(diffs, rangeDiff(api.RangeDiff_RANGE_CHANGE, &o, &n))
+		}
+	}
+
+	for oldIdx, o := range oldRanges {
+		for newIdx, n := range newRanges {
+			if !newMatched[newIdx] && o.Description == n.Description {
+				match(oldIdx, newIdx)
+				break
+			}
+		}
+	}
+	for oldIdx, o := range oldRanges {
+		if oldMatched[oldIdx] {
+			continue
+		}
+		start, end := mapped(o)
+		for newIdx, n := range newRanges {
+			if !newMatched[newIdx] && start < n.EndPos && n.StartPos < end {
+				match(oldIdx, newIdx)
+				break
+			}
+		}
+	}
+	for oldIdx, o := range oldRanges {
+		if !oldMatched[oldIdx] {
+			diffs = append(diffs, rangeDiff(api.RangeDiff_DELETE, &o, nil))
+		}
+	}
+	for newIdx, n := range newRanges {
+		if !newMatched[newIdx] {
+			diffs = append(diffs, rangeDiff(api.RangeDiff_INSERT, nil, &n))
+		}
+	}
+
+	// order by the position of the change in the new transcript.
+	slices.SortStableFunc(diffs, func(a, b *api.RangeDiff) int {
+		return int(diffPosition(a, positions) - diffPosition(b, positions))
+	})
+	return diffs
+}
+
+func diffPosition(d *api.RangeDiff, positions PositionMap) int64 {
+	if d.Kind == api.RangeDiff_DELETE {
+		return positions.Get(int64(d.OldStartPos))
+	}
+	return int64(d.NewStartPos)
+}
+
+func rangeDiff(kind api.RangeDiff_Kind, o *Range, n *Range) *api.RangeDiff {
+	d := &api.RangeDiff{Kind: kind}
+	if o != nil {
+		d.OldDescription, d.OldStartPos, d.OldEndPos = o.Description, int32(o.StartPos), int32(o.EndPos)
+	}
+	if n != nil {
+		d.NewDescription, d.NewStartPos, d.NewEndPos = n.Description, int32(n.StartPos), int32(n.EndPos)
+	}
+	return d
+}
+
+// dialogKeys are used to find lines that have not changed at all.
+func dialogKeys(dialog []models.Dialog) []string {
+	keys := make([]string, len(dialog))
+	for k, d := range dialog {
+		if d.Type == models.DialogTypeGap {
+			keys[k] = fmt.Sprintf("#GAP:%s", d.Duration)
+			continue
+		}
+		keys[k] = fmt.Sprintf("%s:%t:%s", actorLabel(d), d.Notable, d.Content)
+	}
+	return keys
+}
+
+// actorLabel is the actor as it appears in the raw transcript.
+func actorLabel(d models.Dialog) string {
+	switch d.Type {
+	case models.DialogTypeSong:
+		return "song"
+	case models.DialogTypeGap:
+		return ""
+	}
+	if d.Actor == "" {
+		return "none"
+	}
+	return d.Actor
+}
+
+// explicitOffset is the timestamp set with an #OFFSET tag. Inferred timestamps are ignored as they are not
+// part of the raw transcript.
+func explicitOffset(d models.Dialog) time.Duration {
+	if d.TimestampInferred {
+		return 0
+	}
+	return d.Timestamp
+}
@@ -0,0 +1,140 @@
+package diff
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/models"
+	"github.com/warmans/rsk-search/pkg/transcript"
+)
+
+func importTranscript(t testing.TB, raw string) *models.Transcript {
+	ts, err := transcript.Import(bufio.NewScanner(strings.NewReader(raw)), "ep-test", 1)
+	require.NoError(t, err)
+	return ts
+}
+
+// describeDialogDiffs summarises each diff as the kind and the positions of the old and new lines.
+func describeDialogDiffs(diffs []*api.DialogDiff) []string {
+	out := []string{}
+	for _, d := range diffs {
+		out = append(out, fmt.Sprintf("%s %d %d", d.Kind, d.OldDialog.GetPos(), d.NewDialog.GetPos()))
+	}
+	return out
+}
+
+func describeRangeDiffs(diffs []*api.RangeDiff) []string {
+	out := []string{}
+	for _, d := range diffs {
+		out = append(out, fmt.Sprintf("%s %q %d-%d %q %d-%d", d.Kind, d.OldDescription, d.OldStartPos, d.OldEndPos, d.NewDescription, d.NewStartPos, d.NewEndPos))
+	}
+	return out
+}
+
+func TestTranscripts(t *testing.T) {
+	tests := []struct {
+		name           string
+		oldRaw         string
+		newRaw         string
+		expectDialog   []string
+		expectSynopsis []string
+		expectTrivia   []string
+	}{
+		{
+			name:           "no changes",
+			oldRaw:         "#SYN: Intro\nricky: Hello\n#/SYN\nkarl: Alright\n",
+			newRaw:         "#SYN: Intro\nricky: Hello\n#/SYN\nkarl: Alright\n",
+			expectDialog:   []string{},
+			expectSynopsis: []string{},
+			expectTrivia:   []string{},
+		},
+		{
+			name:           "insert and delete",
+			oldRaw:         "ricky: Hello\nkarl: Alright\nsteve: Hi\n",
+			newRaw:         "ricky: Hello\nsteve: Hi\nkarl: Something completely different\n",
+			expectDialog:   []string{"DELETE 2 0", "INSERT 0 3"},
+			expectSynopsis: []string{},
+			expectTrivia:   []string{},
+		},
+		{
+			name:           "actor, content and offset changes",
+			oldRaw:         "ricky: Hello\n#OFFSET: 10\nkarl: I went to the shop\nsteve: Hi\n",
+			newRaw:         "ricky: Hello\n#OFFSET: 11\nkarl: I went to the shops yesterday\nkarl: Hi\n",
+			expectDialog:   []string{"CONTENT_CHANGE 2 2", "OFFSET_CHANGE 2 2", "ACTOR_CHANGE 3 3"},
+			expectSynopsis: []string{},
+			expectTrivia:   []string{},
+		},
+		{
+			name:           "edited line between inserted lines",
+			oldRaw:         "ricky: Hello\nkarl: What are you on about\nsteve: Bye\n",
+			newRaw:         "ricky: Hello\nsteve: Wait\nkarl: What are you on about now\nsteve: Really\nsteve: Bye\n",
+			expectDialog:   []string{"INSERT 0 2", "CONTENT_CHANGE 2 3", "INSERT 0 4"},
+			expectSynopsis: []string{},
+			expectTrivia:   []string{},
+		},
+		{
+			name:           "ranges move with the dialog",
+			oldRaw:         "#SYN: Intro\nricky: Hello\n#/SYN\n#TRIVIA: Fact\nkarl: Alright\n#/TRIVIA\nsteve: Hi\n",
+			newRaw:         "steve: Before\n#SYN: Intro\nricky: Hello\n#/SYN\n#TRIVIA: Fact\nkarl: Alright\nsteve: Hi\n#/TRIVIA\n",
+			expectDialog:   []string{"INSERT 0 1"},
+			expectSynopsis: []string{},
+			expectTrivia:   []string{`RANGE_CHANGE "Fact" 2-3 "Fact" 3-5`},
+		},
+		{
+			name:           "range description changes, inserts and deletes",
+			oldRaw:         "#SYN: Intro\nricky: Hello\n#/SYN\n#SYN: Middle\nkarl: Alright\n#/SYN\nsteve: Hi\n",
+			newRaw:         "#SYN: Introduction\nricky: Hello\n#/SYN\nkarl: Alright\n#SYN: End\nsteve: Hi\n",
+			expectDialog:   []string{},
+			expectSynopsis: []string{`DESCRIPTION_CHANGE "Intro" 1-2 "Introduction" 1-2`, `DELETE "Middle" 2-3 "" 0-0`, `INSERT "" 0-0 "End" 3-4`},
+			expectTrivia:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := Transcripts(importTranscript(t, tt.oldRaw), importTranscript(t, tt.newRaw))
+			require.EqualValues(t, tt.expectDialog, describeDialogDiffs(res.DialogDiffs))
+			require.EqualValues(t, tt.expectSynopsis, describeRangeDiffs(res.SynopsisDiffs))
+			require.EqualValues(t, tt.expectTrivia, describeRangeDiffs(res.TriviaDiffs))
+		})
+	}
+}
+
+func TestWords(t *testing.T) {
+	words := []string{}
+	for _, w := range Words("I went to the shop on Monday", "I went to the shops on Monday morning") {
+		words = append(words, fmt.Sprintf("%s:%s", w.Op, w.Text))
+	}
+	require.EqualValues(t, []string{"EQUAL:I went to the", "DELETE:shop", "INSERT:shops", "EQUAL:on Monday", "INSERT:morning"}, words)
+}
+
+// BenchmarkRewrittenBlock compares a block of lines where every line was changed. Half the lines are edits of
+// the old line and the rest were rewritten. The small vocabulary means few pairs can be ruled out by their words.
+func BenchmarkRewrittenBlock(b *testing.B) {
+	words := strings.Fields("monkey news karl ricky steve manc chimp telly rockbusters little head orange moon shed")
+	sentence := func(seed int) string {
+		out := make([]string, 12)
+		for k := range out {
+			out[k] = words[(seed*7+k*k+k*seed)%len(words)]
+		}
+		return strings.Join(out, " ")
+	}
+	oldRaw, newRaw := &strings.Builder{}, &strings.Builder{}
+	for k := range 200 {
+		fmt.Fprintf(oldRaw, "karl: %s\n", sentence(k))
+		if k%2 == 0 {
+			fmt.Fprintf(newRaw, "karl: %s again\n", sentence(k))
+		} else {
+			fmt.Fprintf(newRaw, "steve: %s\n", sentence(k+1000))
+		}
+	}
+	oldTs, newTs := importTranscript(b, oldRaw.String()), importTranscript(b, newRaw.String())
+
+	b.ResetTimer()
+	for range b.N {
+		Dialog(oldTs.Transcript, newTs.Transcript)
+	}
+}
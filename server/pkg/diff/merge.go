@@ -0,0 +1,358 @@
+package diff
+
+import (
+	"bufio"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/warmans/rsk-search/pkg/models"
+	"github.com/warmans/rsk-search/pkg/transcript"
+)
+
+// Conflict is a line that was changed in different ways by the current transcript and the change being
+// merged.
+type Conflict struct {
+	// Position of the line in the base transcript. Conflicting inserts are before this line.
+	Position int64
+	Reason   string
+	Current  []models.Dialog
+	Change   []models.Dialog
+}
+
+func (c Conflict) String() string {
+	if len(c.Current) == 0 && len(c.Change) == 0 {
+		// synopses and trivia are not tied to a line.
+		return c.Reason
+	}
+	return fmt.Sprintf("line %d: %s (current: %s, change: %s)", c.Position, c.Reason, conflictLines(c.Current), conflictLines(c.Change))
+}
+
+func conflictLines(dialog []models.Dialog) string {
+	if len(dialog) == 0 {
+		return "<deleted>"
+	}
+	lines := make([]string, len(dialog))
+	for k, d := range dialog {
+		lines[k] = fmt.Sprintf("%q", fmt.Sprintf("%s: %s", actorLabel(d), d.Content))
+	}
+	return strings.Join(lines, ", ")
+}
+
+// lineFields are the parts of a line that can be changed independently.
+type lineFields struct {
+	dialogType models.DialogType
+	actor      string
+	content    string
+	notable    bool
+	offset     time.Duration
+	duration   time.Duration
+}
+
+func fieldsOf(d models.Dialog) lineFields {
+	f := lineFields{dialogType: d.Type, actor: actorLabel(d), content: d.Content, notable: d.Notable, offset: explicitOffset(d)}
+	if d.Type == models.DialogTypeGap {
+		f.duration = d.Duration
+	}
+	return f
+}
+
+// sideLines describes how one side changed the base dialog.
+type sideLines struct {
+	dialog []models.Dialog
+	// lines is the index of each base line in the side's dialog, or -1 if it was deleted.
+	lines []int
+	// inserts are the lines inserted before each base line. The last element are inserts at the end.
+	inserts [][]int
+}
+
+func newSideLines(base []models.Dialog, side []models.Dialog) sideLines {
+	s := sideLines{dialog: side, lines: make([]int, len(base)), inserts: make([][]int, len(base)+1)}
+	aligned := align(base, side)
+	for k, l := range aligned {
+		if l.oldIdx != -1 {
+			s.lines[l.oldIdx] = l.newIdx
+			continue
+		}
+		// inserts are anchored to the next base line.
+		before := len(base)
+		for _, next := range aligned[k+1:] {
+			if next.oldIdx != -1 {
+				before = next.oldIdx
+				break
+			}
+		}
+		s.inserts[before] = append(s.inserts[before], l.newIdx)
+	}
+	return s
+}
+
+func (s sideLines) changed(base []models.Dialog, idx int) bool {
+	return s.lines[idx] == -1 || fieldsOf(base[idx]) != fieldsOf(s.dialog[s.lines[idx]])
+}
+
+func (s sideLines) get(indexes ...int) []models.Dialog {
+	out := []models.Dialog{}
+	for _, idx := range indexes {
+		if idx != -1 {
+			out = append(out, s.dialog[idx])
+		}
+	}
+	return out
+}
+
+// Merge combines the changes made to base by the current transcript and by a change. Lines changed by only one
+// side are taken from that side. If both sides changed the same line the individual fields are merged, and if
+// both changed the same field differently the line is a conflict. Synopses and trivia are taken from whichever
+// side changed them and conflict if both did. The merged transcript is only valid if there are no conflicts.
+func Merge(episodeID string, base *models.Transcript, current *models.Transcript, change *models.Transcript) (*models.Transcript, []Conflict) {
+	cur := newSideLines(base.Transcript, current.Transcript)
+	chg := newSideLines(base.Transcript, change.Transcript)
+
+	merged := []models.Dialog{}
+	conflicts := []Conflict{}
+	// the merged index of each line of the current and change dialog.
+	curMerged, chgMerged := map[int]int{}, map[int]int{}
+
+	emit := func(d models.Dialog, curIdx int, chgIdx int) {
+		if curIdx != -1 {
+			curMerged[curIdx] = len(merged)
+		}
+		if chgIdx != -1 {
+			chgMerged[chgIdx] = len(merged)
+		}
+		merged = append(merged, d)
+	}
+
+	for k := 0; k <= len(base.Transcript); k++ {
+		var position int64
+		if k < len(base.Transcript) {
+			position = base.Transcript[k].Position
+		} else if len(base.Transcript) > 0 {
+			position = base.Transcript[len(base.Transcript)-1].Position + transcript.PosSpacing
+		}
+
+		curInserts, chgInserts := cur.inserts[k], chg.inserts[k]
+		switch {
+		case len(curInserts) > 0 && len(chgInserts) > 0:
+			if slices.Equal(dialogKeys(cur.get(curInserts...)), dialogKeys(chg.get(chgInserts...))) {
+				for i, idx := range curInserts {
+					emit(cur.dialog[idx], idx, chgInserts[i])
+				}
+			} else {
+				conflicts = append(conflicts, Conflict{Position: position, Reason: "lines inserted in the same place", Current: cur.get(curInserts...), Change: chg.get(chgInserts...)})
+			}
+		case len(curInserts) > 0:
+			for _, idx := range curInserts {
+				emit(cur.dialog[idx], idx, -1)
+			}
+		case len(chgInserts) > 0:
+			for _, idx := range chgInserts {
+				emit(chg.dialog[idx], -1, idx)
+			}
+		}
+		if k == len(base.Transcript) {
+			break
+		}
+
+		curIdx, chgIdx := cur.lines[k], chg.lines[k]
+		curChanged, chgChanged := cur.changed(base.Transcript, k), chg.changed(base.Transcript, k)
+		switch {
+		case !chgChanged:
+			if curIdx != -1 {
+				emit(cur.dialog[curIdx], curIdx, chgIdx)
+			}
+		case !curChanged:
+			if chgIdx != -1 {
+				emit(chg.dialog[chgIdx], curIdx, chgIdx)
+			}
+		case curIdx == -1 && chgIdx == -1:
+			// deleted by both.
+		case curIdx == -1 || chgIdx == -1:
+			conflicts = append(conflicts, Conflict{Position: position, Reason: "line changed and deleted", Current: cur.get(curIdx), Change: chg.get(chgIdx)})
+		default:
+			line, ok := mergeLine(base.Transcript[k], cur.dialog[curIdx], chg.dialog[chgIdx])
+			if !ok {
+				conflicts = append(conflicts, Conflict{Position: position, Reason: "line changed differently", Current: cur.get(curIdx), Change: chg.get(chgIdx)})
+				continue
+			}
+			emit(line, curIdx, chgIdx)
+		}
+	}
+
+	for k := range merged {
+		merged[k].Position = int64(k+1) * transcript.PosSpacing
+		merged[k].ID = models.DialogID(episodeID, merged[k].Position)
+	}
+
+	result := &models.Transcript{Transcript: merged, Synopsis: []models.Synopsis{}, Trivia: []models.Trivia{}}
+	curPositions := PositionMap{mapped: map[int64]int64{}, oldDialog: current.Transcript, newDialog: merged}
+	for curIdx, mergedIdx := range curMerged {
+		curPositions.mapped[current.Transcript[curIdx].Position] = merged[mergedIdx].Position
+	}
+	chgPositions := PositionMap{mapped: map[int64]int64{}, oldDialog: change.Transcript, newDialog: merged}
+	for chgIdx, mergedIdx := range chgMerged {
+		chgPositions.mapped[change.Transcript[chgIdx].Position] = merged[mergedIdx].Position
+	}
+
+	var rangeConflict *Conflict
+	var synopsis, trivia []Range
+	synopsis, rangeConflict = mergeRanges(
+		"synopsis",
+		synopsisRanges(base.Synopsis), synopsisRanges(current.Synopsis), synopsisRanges(change.Synopsis),
+		cur, chg,
+		base.Transcript, curPositions, chgPositions,
+	)
+	if rangeConflict != nil {
+		conflicts = append(conflicts, *rangeConflict)
+	}
+	for _, r := range synopsis {
+		result.Synopsis = append(result.Synopsis, models.Synopsis{Description: r.Description, StartPos: r.StartPos, EndPos: r.EndPos})
+	}
+	trivia, rangeConflict = mergeRanges(
+		"trivia",
+		triviaRanges(base.Trivia), triviaRanges(current.Trivia), triviaRanges(change.Trivia),
+		cur, chg,
+		base.Transcript, curPositions, chgPositions,
+	)
+	if rangeConflict != nil {
+		conflicts = append(conflicts, *rangeConflict)
+	}
+	for _, r := range trivia {
+		result.Trivia = append(result.Trivia, models.Trivia{Description: r.Description, StartPos: r.StartPos, EndPos: r.EndPos})
+	}
+	return result, conflicts
+}
+
+// mergeLine combines the changes made to a line by both sides.
+func mergeLine(base models.Dialog, current models.Dialog, change models.Dialog) (models.Dialog, bool) {
+	b, cur, chg := fieldsOf(base), fieldsOf(current), fieldsOf(change)
+	ok := true
+	// useChange is true if only the change modified the field.
+	useChange := func(b, cur, chg any) bool {
+		switch {
+		case cur == chg || chg == b:
+			return false
+		case cur == b:
+			return true
+		}
+		ok = false
+		return false
+	}
+
+	line := current
+	// both fields must be checked for conflicts even though they are applied together.
+	typeChanged := useChange(b.dialogType, cur.dialogType, chg.dialogType)
+	actorChanged := useChange(b.actor, cur.actor, chg.actor)
+	if typeChanged || actorChanged {
+		line.Type, line.Actor = change.Type, change.Actor
+	}
+	if useChange(b.content, cur.content, chg.content) {
+		line.Content = change.Content
+	}
+	if useChange(b.notable, cur.notable, chg.notable) {
+		line.Notable = change.Notable
+	}
+	if useChange(b.offset, cur.offset, chg.offset) {
+		line.Timestamp, line.TimestampInferred = change.Timestamp, change.TimestampInferred
+	}
+	if useChange(b.duration, cur.duration, chg.duration) {
+		line.Duration = change.Duration
+	}
+	return line, ok
+}
+
+// mergeRanges takes the synopses or trivia from the side that changed them. Ranges are only considered changed
+// if they were edited, not if they moved because of changes to the dialog.
+func mergeRanges(
+	name string,
+	base []Range,
+	current []Range,
+	change []Range,
+	cur sideLines,
+	chg sideLines,
+	baseDialog []models.Dialog,
+	curPositions PositionMap,
+	chgPositions PositionMap,
+) ([]Range, *Conflict) {
+
+	sidePositions := func(s sideLines) PositionMap {
+		p := PositionMap{mapped: map[int64]int64{}, oldDialog: baseDialog, newDialog: s.dialog}
+		for k, idx := range s.lines {
+			if idx != -1 {
+				p.mapped[baseDialog[k].Position] = s.dialog[idx].Position
+			}
+		}
+		return p
+	}
+	curChanged := len(Ranges(base, current, sidePositions(cur))) > 0
+	chgChanged := len(Ranges(base, change, sidePositions(chg))) > 0
+
+	remap := func(ranges []Range, positions PositionMap) []Range {
+		out := make([]Range, len(ranges))
+		for k, r := range ranges {
+			out[k] = Range{Description: r.Description, StartPos: positions.Get(r.StartPos), EndPos: positions.Get(r.EndPos)}
+		}
+		return out
+	}
+	curRanges, chgRanges := remap(current, curPositions), remap(change, chgPositions)
+	if !chgChanged || slices.Equal(curRanges, chgRanges) {
+		return curRanges, nil
+	}
+	if !curChanged {
+		return chgRanges, nil
+	}
+	return curRanges, &Conflict{Reason: fmt.Sprintf("%s changed by both", name)}
+}
+
+// MergeChange applies a transcript change to the current version of an episode. If the change recorded the
+// transcript it was based on, any edits made to the episode since are merged with the change. Changes without
+// a base can only be applied to the version of the episode they were created from.
+func MergeChange(current *models.Transcript, change *models.TranscriptChange) (*models.Transcript, []Conflict, error) {
+	currentRaw, err := transcript.Export(current.Transcript, current.Synopsis, current.Trivia)
+	if err != nil {
+		return nil, nil, err
+	}
+	theirs, err := importRaw(change.Transcription, current.ID())
+	if err != nil {
+		return nil, nil, err
+	}
+	if change.BaseTranscription == "" {
+		if normalizeVersion(change.TranscriptVersion) != normalizeVersion(current.Version) {
+			return nil, []Conflict{{
+				Reason: fmt.Sprintf(
+					"change was based on version %s but the episode is now version %s and the base transcript was not recorded",
+					change.TranscriptVersion,
+					current.Version,
+				),
+			}}, nil
+		}
+		return theirs, nil, nil
+	}
+	if change.BaseTranscription == currentRaw {
+		return theirs, nil, nil
+	}
+	base, err := importRaw(change.BaseTranscription, current.ID())
+	if err != nil {
+		return nil, nil, err
+	}
+	ours, err := importRaw(currentRaw, current.ID())
+	if err != nil {
+		return nil, nil, err
+	}
+	merged, conflicts := Merge(current.ID(), base, ours, theirs)
+	return merged, conflicts, nil
+}
+
+func importRaw(raw string, episodeID string) (*models.Transcript, error) {
+	return transcript.Import(bufio.NewScanner(strings.NewReader(raw)), episodeID, 1)
+}
+
+// normalizeVersion handles changes created against episodes with no version.
+func normalizeVersion(version string) string {
+	if version == "NONE" {
+		return ""
+	}
+	return version
+}
@@ -0,0 +1,150 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/pkg/models"
+	"github.com/warmans/rsk-search/pkg/transcript"
+)
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name            string
+		base            string
+		current         string
+		change          string
+		want            string
+		expectConflicts []string
+	}{
+		{
+			name:    "changes to different lines",
+			base:    "ricky: Hello\nkarl: Alright\nsteve: Hi\n",
+			current: "ricky: Hello there\nkarl: Alright\nsteve: Hi\n",
+			change:  "ricky: Hello\nkarl: Alright\nsteve: Hi\nkarl: Bye\n",
+			want:    "ricky: Hello there\nkarl: Alright\nsteve: Hi\nkarl: Bye\n",
+		},
+		{
+			name:    "different fields of the same line",
+			base:    "ricky: Hello\nkarl: I went to the shop\nsteve: Hi\n",
+			current: "ricky: Hello\nsteve: I went to the shop\nsteve: Hi\n",
+			change:  "ricky: Hello\nkarl: I went to the shops\nsteve: Hi\n",
+			want:    "ricky: Hello\nsteve: I went to the shops\nsteve: Hi\n",
+		},
+		{
+			name:    "same change on both sides",
+			base:    "ricky: Hello\nkarl: Alright\n",
+			current: "ricky: Hello\nsteve: Wait\nkarl: Alright mate\n",
+			change:  "ricky: Hello\nsteve: Wait\nkarl: Alright mate\n",
+			want:    "ricky: Hello\nsteve: Wait\nkarl: Alright mate\n",
+		},
+		{
+			name:            "line changed differently",
+			base:            "ricky: Hello\nkarl: I went to the shop\n",
+			current:         "ricky: Hello\nkarl: I went to the shops\n",
+			change:          "ricky: Hello\nkarl: I went to the shop yesterday\n",
+			expectConflicts: []string{`line 2: line changed differently (current: "karl: I went to the shops", change: "karl: I went to the shop yesterday")`},
+		},
+		{
+			name:            "type and actor changed differently",
+			base:            "karl: hello there\n",
+			current:         "steve: hello there\n",
+			change:          "song: hello there\n",
+			expectConflicts: []string{`line 1: line changed differently (current: "steve: Hello there", change: "song: Hello there")`},
+		},
+		{
+			name:            "line changed and deleted",
+			base:            "ricky: Hello\nkarl: I went to the shop\nsteve: Hi\n",
+			current:         "ricky: Hello\nsteve: Hi\n",
+			change:          "ricky: Hello\nkarl: I went to the shops\nsteve: Hi\n",
+			expectConflicts: []string{`line 2: line changed and deleted (current: <deleted>, change: "karl: I went to the shops")`},
+		},
+		{
+			name:            "lines inserted in the same place",
+			base:            "ricky: Hello\nsteve: Hi\n",
+			current:         "ricky: Hello\nkarl: Alright\nsteve: Hi\n",
+			change:          "ricky: Hello\nkarl: What\nsteve: Hi\n",
+			expectConflicts: []string{`line 2: lines inserted in the same place (current: "karl: Alright", change: "karl: What")`},
+		},
+		{
+			name:    "ranges changed on one side",
+			base:    "ricky: Hello\nkarl: Alright\nsteve: Hi\n",
+			current: "steve: Before\nricky: Hello\nkarl: Alright\nsteve: Hi\n",
+			change:  "ricky: Hello\n#SYN: Karl\nkarl: Alright\n#/SYN\nsteve: Hi\n",
+			want:    "steve: Before\nricky: Hello\n#SYN: Karl\nkarl: Alright\n#/SYN\nsteve: Hi\n",
+		},
+		{
+			name:            "ranges changed on both sides",
+			base:            "ricky: Hello\nkarl: Alright\n",
+			current:         "#SYN: Intro\nricky: Hello\n#/SYN\nkarl: Alright\n",
+			change:          "ricky: Hello\n#SYN: Karl\nkarl: Alright\n#/SYN\n",
+			expectConflicts: []string{`synopsis changed by both`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, conflicts := Merge("ep-test", importTranscript(t, tt.base), importTranscript(t, tt.current), importTranscript(t, tt.change))
+
+			conflictStrings := []string{}
+			for _, c := range conflicts {
+				conflictStrings = append(conflictStrings, c.String())
+			}
+			if tt.expectConflicts != nil {
+				require.EqualValues(t, tt.expectConflicts, conflictStrings)
+				return
+			}
+			require.Empty(t, conflictStrings)
+			require.EqualValues(t, importTranscript(t, tt.want), merged)
+		})
+	}
+}
+
+func TestMergeChange(t *testing.T) {
+	current := importTranscript(t, "ricky: Hello\nkarl: Alright\nsteve: Hi\n")
+	current.Publication, current.Series, current.Episode, current.Version = "test", 1, 1, "0.0.1"
+
+	base, err := transcript.Export(current.Transcript, current.Synopsis, current.Trivia)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		change          *models.TranscriptChange
+		want            string
+		expectConflicts bool
+	}{
+		{
+			name:   "change based on the current transcript",
+			change: &models.TranscriptChange{TranscriptVersion: "0.0.1", BaseTranscription: base, Transcription: "ricky: Hello\nkarl: Alright mate\nsteve: Hi\n"},
+			want:   "ricky: Hello\nkarl: Alright mate\nsteve: Hi\n",
+		},
+		{
+			name:   "change based on an older transcript",
+			change: &models.TranscriptChange{TranscriptVersion: "0.0.0", BaseTranscription: "ricky: Hello\nkarl: Alright\n", Transcription: "ricky: Hello there\nkarl: Alright\n"},
+			want:   "ricky: Hello there\nkarl: Alright\nsteve: Hi\n",
+		},
+		{
+			name:   "no base on the current version",
+			change: &models.TranscriptChange{TranscriptVersion: "0.0.1", Transcription: "ricky: Bye\n"},
+			want:   "ricky: Bye\n",
+		},
+		{
+			name:            "no base on an older version",
+			change:          &models.TranscriptChange{TranscriptVersion: "0.0.0", Transcription: "ricky: Bye\n"},
+			expectConflicts: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, conflicts, err := MergeChange(current, tt.change)
+			require.NoError(t, err)
+			if tt.expectConflicts {
+				require.NotEmpty(t, conflicts)
+				return
+			}
+			require.Empty(t, conflicts)
+			want, err := importRaw(tt.want, current.ID())
+			require.NoError(t, err)
+			require.EqualValues(t, want.Transcript, merged.Transcript)
+		})
+	}
+}
@@ -231,7 +231,7 @@ func (b *SearchCommand) handleAutocomplete(s *discordgo.Session, i *discordgo.In
 	if err != nil {
 		return err
 	}
-	if len(terms) == 0 {
+	if terms == nil {
 		if err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionApplicationCommandAutocompleteResult,
 			Data: &discordgo.InteractionResponseData{
@@ -243,7 +243,7 @@ func (b *SearchCommand) handleAutocomplete(s *discordgo.Session, i *discordgo.In
 		return nil
 	}
 
-	filterString, err := filter.Print(searchterms.TermsToFilter(terms))
+	filterString, err := filter.Print(terms)
 	if err != nil {
 		return fmt.Errorf("failed to create filter: %w", err)
 	}
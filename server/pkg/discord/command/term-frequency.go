@@ -0,0 +1,116 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/chart"
+	"github.com/warmans/rsk-search/pkg/discord"
+	"go.uber.org/zap"
+)
+
+func NewTermFrequencyCommand(
+	logger *zap.Logger,
+	searchApiClient api.SearchServiceClient) *TermFrequencyCommand {
+	return &TermFrequencyCommand{
+		logger:          logger,
+		searchApiClient: searchApiClient,
+	}
+}
+
+type TermFrequencyCommand struct {
+	logger          *zap.Logger
+	searchApiClient api.SearchServiceClient
+}
+
+func (r *TermFrequencyCommand) Kind() discordgo.ApplicationCommandOptionType {
+	return discordgo.ApplicationCommandOptionString
+}
+
+func (r *TermFrequencyCommand) Name() string {
+	return "scrimp-term-frequency"
+}
+
+func (r *TermFrequencyCommand) Description() string {
+	return "Chart how often words or phrases were said in each episode"
+}
+
+func (r *TermFrequencyCommand) Options() []*discordgo.ApplicationCommandOption {
+	return []*discordgo.ApplicationCommandOption{
+		{
+			Name:        "terms",
+			Description: "Comma separated words or phrases e.g. monkey, little monkey",
+			Type:        discordgo.ApplicationCommandOptionString,
+			Required:    true,
+		},
+		{
+			Name:        "by-actor",
+			Description: "Show a line per actor",
+			Type:        discordgo.ApplicationCommandOptionBoolean,
+			Required:    false,
+		},
+	}
+}
+
+func (r *TermFrequencyCommand) ButtonHandlers() discord.InteractionHandlers {
+	return discord.InteractionHandlers{}
+}
+
+func (r *TermFrequencyCommand) ModalHandlers() discord.InteractionHandlers {
+	return discord.InteractionHandlers{}
+}
+
+func (r *TermFrequencyCommand) CommandHandlers() discord.InteractionHandlers {
+	return discord.InteractionHandlers{
+		r.Name(): r.handleInitialInvocation,
+	}
+}
+
+func (r *TermFrequencyCommand) AutoCompleteHandler() discord.InteractionHandler {
+	return nil
+}
+
+func (r *TermFrequencyCommand) MessageHandlers() discord.MessageHandlers {
+	return discord.MessageHandlers{}
+}
+
+func (r *TermFrequencyCommand) handleInitialInvocation(s *discordgo.Session, i *discordgo.InteractionCreate, args ...string) error {
+	terms := []string{}
+	byActor := false
+	for _, opt := range i.ApplicationCommandData().Options {
+		switch opt.Name {
+		case "terms":
+			for _, t := range strings.Split(opt.StringValue(), ",") {
+				if t = strings.TrimSpace(t); t != "" {
+					terms = append(terms, t)
+				}
+			}
+		case "by-actor":
+			byActor = opt.BoolValue()
+		}
+	}
+	if len(terms) == 0 {
+		return fmt.Errorf("no terms given")
+	}
+
+	canvas, err := chart.GenerateTermFrequencyChart(context.Background(), r.searchApiClient, terms, byActor)
+	if err != nil {
+		return err
+	}
+	buff := &bytes.Buffer{}
+	if err := canvas.EncodePNG(buff); err != nil {
+		return err
+	}
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("Frequency of %s per 10,000 words", strings.Join(terms, ", ")),
+			Files:   []*discordgo.File{{Name: "term-frequency.png", ContentType: "image/png", Reader: buff}},
+		},
+	})
+}
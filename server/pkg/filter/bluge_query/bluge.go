@@ -4,17 +4,41 @@ import (
 	"fmt"
 	"github.com/blugelabs/bluge"
 	"github.com/warmans/rsk-search/pkg/filter"
+	"github.com/warmans/rsk-search/pkg/meta"
+	"github.com/warmans/rsk-search/pkg/phonetic"
 	"github.com/warmans/rsk-search/pkg/search/v2/mapping"
 	"math"
+	"slices"
 	"strings"
 	"time"
+	"unicode"
 )
 
-func FilterToQuery(f filter.Filter) (bluge.Query, error) {
+// maxAliasVariants limits the number of alternative phrases a single condition is expanded to.
+const maxAliasVariants = 10
+
+type QueryOption func(opts *queryOptions)
+
+type queryOptions struct {
+	expandAliases bool
+}
+
+// WithAliasExpansion also matches terms with the same meaning as those given in equality and like conditions
+// e.g. actor = "ricky and steve" will also match "steve and ricky".
+func WithAliasExpansion() QueryOption {
+	return func(opts *queryOptions) {
+		opts.expandAliases = true
+	}
+}
+
+func FilterToQuery(f filter.Filter, opts ...QueryOption) (bluge.Query, error) {
 	if f == nil {
 		return bluge.NewMatchAllQuery(), nil
 	}
 	q := NewBlugeQuery()
+	for _, opt := range opts {
+		opt(&q.opts)
+	}
 	if err := f.Accept(q); err != nil {
 		return nil, err
 	}
@@ -26,7 +50,8 @@ func NewBlugeQuery() *BlugeQuery {
 }
 
 type BlugeQuery struct {
-	q *bluge.BooleanQuery
+	q    *bluge.BooleanQuery
+	opts queryOptions
 }
 
 func (j *BlugeQuery) VisitCompFilter(f *filter.CompFilter) (filter.Visitor, error) {
@@ -65,6 +90,17 @@ func (j *BlugeQuery) VisitBoolFilter(f *filter.BoolFilter) (filter.Visitor, erro
 	return nil, nil
 }
 
+func (j *BlugeQuery) VisitNotFilter(f *filter.NotFilter) (filter.Visitor, error) {
+
+	inner := &BlugeQuery{q: bluge.NewBooleanQuery(), opts: j.opts}
+	if err := f.Filter.Accept(inner); err != nil {
+		return nil, err
+	}
+	j.q.AddMust(bluge.NewBooleanQuery().AddMustNot(inner.q))
+
+	return nil, nil
+}
+
 func (j *BlugeQuery) condition(field string, op filter.CompOp, value filter.Value) (bluge.Query, error) {
 
 	switch op {
@@ -80,11 +116,66 @@ func (j *BlugeQuery) condition(field string, op filter.CompOp, value filter.Valu
 		q := bluge.NewMatchQuery(stripQuotes(value.String()))
 		q.SetField(field)
 		q.SetFuzziness(0)
-		return q, nil
+		return j.withAliases(field, q, termAliases(field, stripQuotes(value.String()))), nil
 	case filter.CompOpFuzzyLike:
 		q := bluge.NewMatchQuery(stripQuotes(value.String()))
 		q.SetField(field)
 		q.SetFuzziness(1)
+		return j.withAliases(field, q, termAliases(field, stripQuotes(value.String()))), nil
+	case filter.CompOpPhonetic:
+		phoneticField, ok := mapping.PhoneticField(field)
+		if !ok {
+			return nil, fmt.Errorf("%s operation cannot be used on field %s", string(op), field)
+		}
+		if value.Type() != filter.StringType {
+			return nil, fmt.Errorf("value type %s is not applicable to %s operation", string(value.Type()), string(op))
+		}
+		terms := phoneticTerms(stripQuotes(value.String()))
+		if len(terms) == 0 {
+			return bluge.NewMatchNoneQuery(), nil
+		}
+		return bluge.NewMultiPhraseQuery(terms).SetField(phoneticField), nil
+	case filter.CompOpNear:
+		if mapping.Mapping[field] != mapping.FieldTypeText {
+			return nil, fmt.Errorf("%s operation can only be used on text fields", string(op))
+		}
+		proximity, ok := value.(filter.ProximityValue)
+		if !ok {
+			return nil, fmt.Errorf("value type %s is not applicable to %s operation", string(value.Type()), string(op))
+		}
+		q := bluge.NewMatchPhraseQuery(proximity.Phrase)
+		q.SetField(field)
+		q.SetSlop(int(proximity.Distance))
+		return q, nil
+	case filter.CompOpWildcard:
+		pattern, err := patternValue(field, op, value)
+		if err != nil {
+			return nil, err
+		}
+		q := bluge.NewWildcardQuery(pattern)
+		q.SetField(field)
+		return q, nil
+	case filter.CompOpRegex:
+		pattern, err := patternValue(field, op, value)
+		if err != nil {
+			return nil, err
+		}
+		q := bluge.NewRegexpQuery(pattern)
+		q.SetField(field)
+		return q, nil
+	case filter.CompOpIn:
+		list, ok := value.(filter.ListValue)
+		if !ok {
+			return nil, fmt.Errorf("value type %s is not applicable to %s operation", string(value.Type()), string(op))
+		}
+		q := bluge.NewBooleanQuery().SetMinShould(1)
+		for _, v := range list {
+			cond, err := j.eqFilter(field, v)
+			if err != nil {
+				return nil, err
+			}
+			q.AddShould(cond)
+		}
 		return q, nil
 	case filter.CompOpGt:
 		switch value.Type() {
@@ -97,6 +188,10 @@ func (j *BlugeQuery) condition(field string, op filter.CompOp, value filter.Valu
 			q := bluge.NewNumericRangeQuery(value.Value().(float64), math.MaxFloat64)
 			q.SetField(field)
 			return q, nil
+		case filter.DurationType:
+			q := bluge.NewNumericRangeQuery(value.Value().(time.Duration).Seconds(), math.MaxFloat64)
+			q.SetField(field)
+			return q, nil
 		case filter.StringType:
 			// todo: how to handle dates? they don't have a special type so we would need to look
 			// at the document mapping
@@ -116,6 +211,10 @@ func (j *BlugeQuery) condition(field string, op filter.CompOp, value filter.Valu
 			q := bluge.NewNumericRangeQuery(0-math.MaxFloat64, value.Value().(float64))
 			q.SetField(field)
 			return q, nil
+		case filter.DurationType:
+			q := bluge.NewNumericRangeQuery(0-math.MaxFloat64, value.Value().(time.Duration).Seconds())
+			q.SetField(field)
+			return q, nil
 		case filter.StringType:
 			// todo: how to handle dates? they don't have a special type so we would need to look
 			// at the bleve mapping
@@ -135,6 +234,10 @@ func (j *BlugeQuery) condition(field string, op filter.CompOp, value filter.Valu
 			q := bluge.NewNumericRangeInclusiveQuery(value.Value().(float64), math.MaxFloat64, true, true)
 			q.SetField(field)
 			return q, nil
+		case filter.DurationType:
+			q := bluge.NewNumericRangeInclusiveQuery(value.Value().(time.Duration).Seconds(), math.MaxFloat64, true, true)
+			q.SetField(field)
+			return q, nil
 		case filter.StringType:
 			// todo: how to handle dates? they don't have a special type so we would need to look
 			// at the mapping
@@ -154,6 +257,10 @@ func (j *BlugeQuery) condition(field string, op filter.CompOp, value filter.Valu
 			q := bluge.NewNumericRangeInclusiveQuery(0-math.MaxFloat64, value.Value().(float64), true, true)
 			q.SetField(field)
 			return q, nil
+		case filter.DurationType:
+			q := bluge.NewNumericRangeInclusiveQuery(0-math.MaxFloat64, value.Value().(time.Duration).Seconds(), true, true)
+			q.SetField(field)
+			return q, nil
 		case filter.StringType:
 			// todo: how to handle dates? they don't have a special type so we would need to look
 			// at the bleve mapping
@@ -178,14 +285,14 @@ func (j *BlugeQuery) eqFilter(field string, value filter.Value) (bluge.Query, er
 			}
 			q := bluge.NewMatchPhraseQuery(stripQuotes(value.String()))
 			q.SetField(field)
-			return q, nil
+			return j.withAliases(field, q, phraseAliases(field, stripQuotes(value.String()))), nil
 		case mapping.FieldTypeKeyword, mapping.FieldTypeShingles:
 			if value.Type() != filter.StringType {
 				return nil, fmt.Errorf("could not compare keyword field %s with %s", field, value.Type())
 			}
 			q := bluge.NewTermQuery(stripQuotes(value.String()))
 			q.SetField(field)
-			return q, nil
+			return j.withAliases(field, q, append(meta.Aliases(field, stripQuotes(value.String())), meta.Nicknames(field, stripQuotes(value.String()))...)), nil
 		case mapping.FieldTypeNumber:
 			switch value.Type() {
 			case filter.IntType:
@@ -196,6 +303,19 @@ func (j *BlugeQuery) eqFilter(field string, value filter.Value) (bluge.Query, er
 				q := bluge.NewNumericRangeInclusiveQuery(value.Value().(float64), value.Value().(float64), true, true)
 				q.SetField(field)
 				return q, nil
+			case filter.DurationType:
+				q := bluge.NewNumericRangeInclusiveQuery(value.Value().(time.Duration).Seconds(), value.Value().(time.Duration).Seconds(), true, true)
+				q.SetField(field)
+				return q, nil
+			case filter.BoolType:
+				// bools are indexed as 1 or 0
+				num := float64(0)
+				if value.Value().(bool) {
+					num = 1
+				}
+				q := bluge.NewNumericRangeInclusiveQuery(num, num, true, true)
+				q.SetField(field)
+				return q, nil
 			default:
 				return nil, fmt.Errorf("cannot compare number to %s", value.Type())
 			}
@@ -215,6 +335,121 @@ func (j *BlugeQuery) eqFilter(field string, value filter.Value) (bluge.Query, er
 	return nil, fmt.Errorf("unknown field type %v", t)
 }
 
+// phoneticTerms returns the phonetic codes for each word in the phrase. Words with an alternate pronunciation
+// have multiple codes which will match at the same position.
+func phoneticTerms(phrase string) [][]string {
+	terms := [][]string{}
+	for _, tok := range phonetic.Analyzer.Analyze([]byte(phrase)) {
+		if tok.PositionIncr == 0 && len(terms) > 0 {
+			terms[len(terms)-1] = append(terms[len(terms)-1], string(tok.Term))
+			continue
+		}
+		terms = append(terms, []string{string(tok.Term)})
+	}
+	return terms
+}
+
+// withAliases matches either the query or any of the aliases. Aliases are ignored unless expansion is enabled.
+func (j *BlugeQuery) withAliases(field string, q bluge.Query, aliases []string) bluge.Query {
+	if !j.opts.expandAliases || len(aliases) == 0 {
+		return q
+	}
+	expanded := bluge.NewBooleanQuery().SetMinShould(1).AddShould(q)
+	for _, alias := range aliases {
+		if mapping.Mapping[field] == mapping.FieldTypeText {
+			expanded.AddShould(bluge.NewMatchPhraseQuery(alias).SetField(field))
+		} else {
+			expanded.AddShould(bluge.NewTermQuery(alias).SetField(field))
+		}
+	}
+	return expanded
+}
+
+type aliasSpan struct {
+	start   int
+	end     int
+	aliases []string
+}
+
+// findAliases returns the spans of words that have aliases. The longest span is used where they overlap
+// e.g. "karl pilkington" rather than "karl".
+func findAliases(field string, words []string) []aliasSpan {
+	spans := []aliasSpan{}
+	for i := 0; i < len(words); i++ {
+		for n := len(words) - i; n > 0; n-- {
+			if aliases := meta.Aliases(field, strings.Join(words[i:i+n], " ")); len(aliases) > 0 {
+				spans = append(spans, aliasSpan{start: i, end: i + n, aliases: aliases})
+				i += n - 1
+				break
+			}
+		}
+	}
+	return spans
+}
+
+// phraseAliases returns variants of the phrase with one of its terms replaced by an alias. A multi-word phrase is
+// never shortened as the variant would match lines that don't contain the whole phrase e.g. "all right then" must
+// not become "alright". Nicknames are only used if the phrase is the whole name.
+func phraseAliases(field string, phrase string) []string {
+	words := aliasWords(phrase)
+	variants := meta.Nicknames(field, strings.Join(words, " "))
+	for _, span := range findAliases(field, words) {
+		for _, alias := range span.aliases {
+			variant := slices.Concat(words[:span.start], strings.Fields(alias), words[span.end:])
+			if len(words) > 1 && len(variant) < len(words) {
+				continue
+			}
+			variants = append(variants, strings.Join(variant, " "))
+		}
+	}
+	if len(variants) > maxAliasVariants {
+		variants = variants[:maxAliasVariants]
+	}
+	return variants
+}
+
+// termAliases returns the aliases of any of the terms. Nicknames are only used if the terms are the whole name.
+func termAliases(field string, terms string) []string {
+	aliases := meta.Nicknames(field, strings.Join(aliasWords(terms), " "))
+	for _, span := range findAliases(field, aliasWords(terms)) {
+		aliases = append(aliases, span.aliases...)
+	}
+	if len(aliases) > maxAliasVariants {
+		aliases = aliases[:maxAliasVariants]
+	}
+	return aliases
+}
+
+func aliasWords(text string) []string {
+	words := []string{}
+	for _, w := range strings.Fields(strings.ToLower(text)) {
+		if w = strings.TrimFunc(w, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsNumber(r) }); w != "" {
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+// patternValue returns the wildcard or regex pattern. Patterns match individual terms so for text fields
+// they must be case-insensitive to match the lowercase indexed terms. Regex patterns are made case-insensitive
+// with a flag rather than lowercased as lowercasing would change escapes such as \W.
+func patternValue(field string, op filter.CompOp, value filter.Value) (string, error) {
+	pattern, ok := value.Value().(string)
+	if !ok || value.Type() != filter.StringType {
+		return "", fmt.Errorf("value type %s is not applicable to %s operation", string(value.Type()), string(op))
+	}
+	switch mapping.Mapping[field] {
+	case mapping.FieldTypeText:
+		if op == filter.CompOpWildcard {
+			return strings.ToLower(pattern), nil
+		}
+		return "(?i)" + pattern, nil
+	case mapping.FieldTypeKeyword:
+		return pattern, nil
+	}
+	return "", fmt.Errorf("%s operation can only be used on text or keyword fields", string(op))
+}
+
 func stripQuotes(v string) string {
 	return strings.Trim(v, `"`)
 }
@@ -0,0 +1,125 @@
+package bluge_query
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/blugelabs/bluge"
+)
+
+// DescribeQuery renders the query in a lucene-like syntax for debugging e.g.
+// +(content:"some phrase"~1 actor:karl)~1 -doc_type:synopsis
+func DescribeQuery(q bluge.Query) string {
+	switch q := q.(type) {
+	case *bluge.BooleanQuery:
+		parts := []string{}
+		for _, m := range q.Musts() {
+			parts = append(parts, "+"+DescribeQuery(m))
+		}
+		for _, m := range q.Shoulds() {
+			parts = append(parts, DescribeQuery(m))
+		}
+		for _, m := range q.MustNots() {
+			parts = append(parts, "-"+DescribeQuery(m))
+		}
+		out := fmt.Sprintf("(%s)", strings.Join(parts, " "))
+		if q.MinShould() > 0 {
+			out += fmt.Sprintf("~%d", q.MinShould())
+		}
+		return withBoost(out, q.Boost())
+	case *bluge.MatchAllQuery:
+		return withBoost("*:*", q.Boost())
+	case *bluge.MatchNoneQuery:
+		return "-*:*"
+	case *bluge.TermQuery:
+		return withBoost(fmt.Sprintf("%s:%s", q.Field(), q.Term()), q.Boost())
+	case *bluge.MatchQuery:
+		out := fmt.Sprintf("%s:match(%q)", q.Field(), q.Match())
+		if q.Fuzziness() > 0 {
+			out += fmt.Sprintf("~%d", q.Fuzziness())
+		}
+		return withBoost(out, q.Boost())
+	case *bluge.MatchPhraseQuery:
+		out := fmt.Sprintf("%s:%q", q.Field(), q.Phrase())
+		if q.Slop() > 0 {
+			out += fmt.Sprintf("~%d", q.Slop())
+		}
+		return withBoost(out, q.Boost())
+	case *bluge.MultiPhraseQuery:
+		positions := []string{}
+		for _, terms := range q.Terms() {
+			if len(terms) == 1 {
+				positions = append(positions, terms[0])
+				continue
+			}
+			positions = append(positions, fmt.Sprintf("(%s)", strings.Join(terms, "|")))
+		}
+		out := fmt.Sprintf("%s:%q", q.Field(), strings.Join(positions, " "))
+		if q.Slop() > 0 {
+			out += fmt.Sprintf("~%d", q.Slop())
+		}
+		return withBoost(out, q.Boost())
+	case *bluge.FuzzyQuery:
+		return withBoost(fmt.Sprintf("%s:%s~%d", q.Field(), q.Term(), q.Fuzziness()), q.Boost())
+	case *bluge.PrefixQuery:
+		return withBoost(fmt.Sprintf("%s:%s*", q.Field(), q.Prefix()), q.Boost())
+	case *bluge.WildcardQuery:
+		return withBoost(fmt.Sprintf("%s:%s", q.Field(), q.Wildcard()), q.Boost())
+	case *bluge.RegexpQuery:
+		return withBoost(fmt.Sprintf("%s:/%s/", q.Field(), q.Regexp()), q.Boost())
+	case *bluge.NumericRangeQuery:
+		min, minInclusive := q.Min()
+		max, maxInclusive := q.Max()
+		return withBoost(describeRange(q.Field(), numericBound(min), minInclusive, numericBound(max), maxInclusive), q.Boost())
+	case *bluge.DateRangeQuery:
+		start, startInclusive := q.Start()
+		end, endInclusive := q.End()
+		return withBoost(describeRange(q.Field(), dateBound(start), startInclusive, dateBound(end), endInclusive), q.Boost())
+	case *bluge.TermRangeQuery:
+		min, minInclusive := q.Min()
+		max, maxInclusive := q.Max()
+		return withBoost(describeRange(q.Field(), termBound(min), minInclusive, termBound(max), maxInclusive), q.Boost())
+	}
+	return fmt.Sprintf("%T", q)
+}
+
+func withBoost(out string, boost float64) string {
+	if boost == 1 {
+		return out
+	}
+	return fmt.Sprintf("%s^%g", out, boost)
+}
+
+func describeRange(field string, min string, minInclusive bool, max string, maxInclusive bool) string {
+	open, closed := "{", "}"
+	if minInclusive {
+		open = "["
+	}
+	if maxInclusive {
+		closed = "]"
+	}
+	return fmt.Sprintf("%s:%s%s TO %s%s", field, open, min, max, closed)
+}
+
+func numericBound(v float64) string {
+	if math.IsInf(v, 0) || math.Abs(v) == math.MaxFloat64 {
+		return "*"
+	}
+	return fmt.Sprintf("%g", v)
+}
+
+func dateBound(v time.Time) string {
+	if v.IsZero() {
+		return "*"
+	}
+	return v.Format(time.RFC3339)
+}
+
+func termBound(v string) string {
+	if v == "" {
+		return "*"
+	}
+	return v
+}
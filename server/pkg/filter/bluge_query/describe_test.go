@@ -0,0 +1,54 @@
+package bluge_query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/pkg/filter"
+)
+
+func TestDescribeQuery(t *testing.T) {
+	tests := []struct {
+		filter string
+		opts   []QueryOption
+		expect string
+	}{
+		{filter: ``, expect: `*:*`},
+		{filter: `actor = "karl"`, expect: `(+actor:karl)`},
+		{filter: `content ~= "monkey news"`, expect: `(+content:match("monkey news"))`},
+		{filter: `content ~ "monkey"`, expect: `(+content:match("monkey")~1)`},
+		{filter: `actor = "karl" or actor != "steve"`, expect: `((+actor:karl) (+(-actor:steve)))`},
+		{filter: `series > 1 and offset <= 10s`, expect: `(+(+series:[1 TO *}) +(+offset:[* TO 10]))`},
+		{filter: `content near "monkey news"~2`, expect: `(+content:"monkey news"~2)`},
+		{filter: `content ~* "Pilk*"`, expect: `(+content:pilk*)`},
+		{filter: `actor =~ "k.*"`, expect: `(+actor:/k.*/)`},
+		{filter: `content =~ "Pilk.*"`, expect: `(+content:/(?i)Pilk.*/)`},
+		{filter: `actor in ["karl", "steve"]`, expect: `(+(actor:karl actor:steve)~1)`},
+		{filter: `actor = "karl" and not content ~ "pilk"`, expect: `(+(+actor:karl) +(+(-(+content:match("pilk")~1))))`},
+		{filter: `not content near "pilkington moon"~5`, expect: `(+(-(+content:"pilkington moon"~5)))`},
+		{filter: `content ~p "hoberman"`, expect: `(+content_phonetic:"HPRM")`},
+		{filter: `content ~p "mr smith"`, expect: `(+content_phonetic:"MR (SM0|XMT)")`},
+		{filter: `content = "telly"`, expect: `(+content:"telly")`},
+		{filter: `content = "telly"`, opts: []QueryOption{WithAliasExpansion()}, expect: `(+(content:"telly" content:"television" content:"tv")~1)`},
+		{filter: `content = "mum watches telly"`, opts: []QueryOption{WithAliasExpansion()}, expect: `(+(content:"mum watches telly" content:"mam watches telly" content:"mum watches television" content:"mum watches tv")~1)`},
+		{filter: `content = "Karl Pilkington"`, opts: []QueryOption{WithAliasExpansion()}, expect: `(+(content:"Karl Pilkington" content:"carl pilkington")~1)`},
+		{filter: `content = "karl"`, opts: []QueryOption{WithAliasExpansion()}, expect: `(+(content:"karl" content:"pilkington" content:"the little round-headed buffoon" content:"carl")~1)`},
+		{filter: `content = "Carl"`, opts: []QueryOption{WithAliasExpansion()}, expect: `(+(content:"Carl" content:"pilkington" content:"the little round-headed buffoon" content:"karl")~1)`},
+		{filter: `content = "pilkington"`, opts: []QueryOption{WithAliasExpansion()}, expect: `(+content:"pilkington")`},
+		{filter: `content = "karl said"`, opts: []QueryOption{WithAliasExpansion()}, expect: `(+(content:"karl said" content:"carl said")~1)`},
+		{filter: `content = "alright then"`, opts: []QueryOption{WithAliasExpansion()}, expect: `(+(content:"alright then" content:"all right then")~1)`},
+		{filter: `content = "all right then"`, opts: []QueryOption{WithAliasExpansion()}, expect: `(+content:"all right then")`},
+		{filter: `content ~= "monkey telly"`, opts: []QueryOption{WithAliasExpansion()}, expect: `(+(content:match("monkey telly") content:"television" content:"tv")~1)`},
+		{filter: `actor = "steve and ricky"`, opts: []QueryOption{WithAliasExpansion()}, expect: `(+(actor:steve and ricky actor:ricky and steve actor:ricky & steve actor:richy and steve actor:steve and ricky together)~1)`},
+		{filter: `actor != "karl and ricky"`, opts: []QueryOption{WithAliasExpansion()}, expect: `(+(-(actor:karl and ricky actor:ricky and karl)~1))`},
+	}
+	for _, test := range tests {
+		t.Run(test.filter, func(t *testing.T) {
+			f, err := filter.Parse(test.filter)
+			require.NoError(t, err)
+			q, err := FilterToQuery(f, test.opts...)
+			require.NoError(t, err)
+			require.Equal(t, test.expect, DescribeQuery(q))
+		})
+	}
+}
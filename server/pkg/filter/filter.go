@@ -7,10 +7,15 @@ const (
 	CompOpNeq       CompOp = "!="
 	CompOpLike      CompOp = "~="
 	CompOpFuzzyLike CompOp = "~"
+	CompOpPhonetic  CompOp = "~p"
 	CompOpLt        CompOp = "<"
 	CompOpLe        CompOp = "<="
 	CompOpGt        CompOp = ">"
 	CompOpGe        CompOp = ">="
+	CompOpNear      CompOp = "near"
+	CompOpWildcard  CompOp = "~*"
+	CompOpRegex     CompOp = "=~"
+	CompOpIn        CompOp = "in"
 )
 
 func (op CompOp) Precedence() int {
@@ -38,6 +43,7 @@ func (op BoolOp) Precedence() int {
 type Visitor interface {
 	VisitCompFilter(*CompFilter) (Visitor, error)
 	VisitBoolFilter(*BoolFilter) (Visitor, error)
+	VisitNotFilter(*NotFilter) (Visitor, error)
 }
 
 type Filter interface {
@@ -93,6 +99,33 @@ func (b *BoolFilter) Precedence() int {
 	return b.Op.Precedence()
 }
 
+// NotFilter matches anything the wrapped filter does not match.
+type NotFilter struct {
+	Filter Filter
+}
+
+func (n *NotFilter) Accept(visitor Visitor) error {
+	v, err := visitor.VisitNotFilter(n)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return nil
+	}
+	return n.Filter.Accept(v)
+}
+
+func (n *NotFilter) Precedence() int {
+	return 3
+}
+
+func Not(f Filter) Filter {
+	if f == nil {
+		panic("nil filter included in NOT")
+	}
+	return &NotFilter{Filter: f}
+}
+
 func And(lhs, rhs Filter, filters ...Filter) Filter {
 	// this will panic eventually if you pass a nil filter, so might as well get it out of the way early with a meaningful
 	// error message.
@@ -161,6 +194,31 @@ func FuzzyLike(field string, val Value) Filter {
 	return &CompFilter{Field: field, Op: CompOpFuzzyLike, Value: val}
 }
 
+// Phonetic matches words that sound like the value regardless of spelling.
+func Phonetic(field string, val Value) Filter {
+	return &CompFilter{Field: field, Op: CompOpPhonetic, Value: val}
+}
+
+// Near matches a phrase where the words appear within the ProximityValue's distance of each other.
+func Near(field string, val ProximityValue) Filter {
+	return &CompFilter{Field: field, Op: CompOpNear, Value: val}
+}
+
+// Wildcard matches a pattern where * matches any sequence of characters and ? matches a single character.
+func Wildcard(field string, val Value) Filter {
+	return &CompFilter{Field: field, Op: CompOpWildcard, Value: val}
+}
+
+// Regex matches a regular expression.
+func Regex(field string, val Value) Filter {
+	return &CompFilter{Field: field, Op: CompOpRegex, Value: val}
+}
+
+// In matches any of the values in the list.
+func In(field string, val ListValue) Filter {
+	return &CompFilter{Field: field, Op: CompOpIn, Value: val}
+}
+
 func NewExtractFilterVisitor(f Filter) *ExtractFilterVisitor {
 	return &ExtractFilterVisitor{f: f}
 }
@@ -186,6 +244,10 @@ func (e *ExtractFilterVisitor) VisitBoolFilter(filter *BoolFilter) (Visitor, err
 	return e, filter.RHS.Accept(e)
 }
 
+func (e *ExtractFilterVisitor) VisitNotFilter(filter *NotFilter) (Visitor, error) {
+	return e, filter.Filter.Accept(e)
+}
+
 func (e *ExtractFilterVisitor) ExtractCompFilters(name string) ([]*CompFilter, error) {
 	e.field = name
 	e.fields = []*CompFilter{}
@@ -0,0 +1,216 @@
+package fts5
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/warmans/rsk-search/pkg/filter"
+)
+
+// FilterToMatch converts the filter to an sqlite FTS5 MATCH expression. The filterMapping maps filter fields
+// to indexed columns of the FTS table. The keyMapping maps keyword fields to columns containing the whole value
+// as a single token (see KeyToken) so that =, != and in match the exact value rather than any word in it.
+func FilterToMatch(f filter.Filter, filterMapping map[string]string, keyMapping map[string]string) (string, error) {
+	expr, err := toExpr(f, mapping{fields: filterMapping, keys: keyMapping})
+	if err != nil {
+		return "", err
+	}
+	if expr.negated {
+		// FTS5 only has a binary NOT so there must be something to subtract the negated condition from.
+		return "", errors.New("filter must contain at least one condition that is not negated")
+	}
+	return expr.match, nil
+}
+
+// KeyToken encodes a keyword value as a single token. It must match the sqlite expression 'k' || hex(value)
+// used to populate the key columns. Tokens are case-insensitive so the case of the hex digits does not matter.
+func KeyToken(value string) string {
+	return "k" + hex.EncodeToString([]byte(value))
+}
+
+// expr is a MATCH expression that may need to be negated by the parent expression.
+type expr struct {
+	match   string
+	negated bool
+}
+
+type mapping struct {
+	fields map[string]string
+	keys   map[string]string
+}
+
+func toExpr(f filter.Filter, m mapping) (expr, error) {
+	v := &visitor{mapping: m}
+	err := f.Accept(v)
+	return v.expr, err
+}
+
+type visitor struct {
+	mapping mapping
+
+	expr expr
+}
+
+func (v *visitor) VisitCompFilter(f *filter.CompFilter) (filter.Visitor, error) {
+	var err error
+	v.expr, err = compExpr(f, v.mapping)
+	return nil, err
+}
+
+func (v *visitor) VisitBoolFilter(f *filter.BoolFilter) (filter.Visitor, error) {
+	var err error
+	v.expr, err = boolExpr(f, v.mapping)
+	return nil, err
+}
+
+func (v *visitor) VisitNotFilter(f *filter.NotFilter) (filter.Visitor, error) {
+	inner, err := toExpr(f.Filter, v.mapping)
+	if err != nil {
+		return nil, err
+	}
+	v.expr = expr{match: inner.match, negated: !inner.negated}
+	return nil, nil
+}
+
+func boolExpr(f *filter.BoolFilter, m mapping) (expr, error) {
+	lhs, err := toExpr(f.LHS, m)
+	if err != nil {
+		return expr{}, err
+	}
+	rhs, err := toExpr(f.RHS, m)
+	if err != nil {
+		return expr{}, err
+	}
+
+	switch f.Op {
+	case filter.BoolOpAnd:
+		switch {
+		case !lhs.negated && !rhs.negated:
+			return expr{match: fmt.Sprintf("(%s) AND (%s)", lhs.match, rhs.match)}, nil
+		case !lhs.negated && rhs.negated:
+			return expr{match: fmt.Sprintf("(%s) NOT (%s)", lhs.match, rhs.match)}, nil
+		case lhs.negated && !rhs.negated:
+			return expr{match: fmt.Sprintf("(%s) NOT (%s)", rhs.match, lhs.match)}, nil
+		default:
+			// not a and not b == not (a or b)
+			return expr{match: fmt.Sprintf("(%s) OR (%s)", lhs.match, rhs.match), negated: true}, nil
+		}
+	case filter.BoolOpOr:
+		if lhs.negated || rhs.negated {
+			return expr{}, errors.Errorf("negated conditions cannot be used in an %s", f.Op)
+		}
+		return expr{match: fmt.Sprintf("(%s) OR (%s)", lhs.match, rhs.match)}, nil
+	}
+	return expr{}, fmt.Errorf("unknown operator: %s", string(f.Op))
+}
+
+func compExpr(f *filter.CompFilter, m mapping) (expr, error) {
+	col, ok := m.fields[f.Field]
+	if !ok {
+		return expr{}, errors.Errorf("field is not searchable: '%s'", f.Field)
+	}
+	keyCol, isKey := m.keys[f.Field]
+
+	switch f.Op {
+	case filter.CompOpEq, filter.CompOpNeq:
+		term, err := termValue(f.Op, f.Value)
+		if err != nil {
+			return expr{}, err
+		}
+		if isKey {
+			return expr{match: fmt.Sprintf("%s : %s", keyCol, quote(KeyToken(term))), negated: f.Op == filter.CompOpNeq}, nil
+		}
+		return expr{match: fmt.Sprintf("%s : %s", col, quote(term)), negated: f.Op == filter.CompOpNeq}, nil
+	case filter.CompOpLike, filter.CompOpFuzzyLike:
+		// FTS5 has no fuzzy matching so fuzzy like is the same as like.
+		str, ok := f.Value.Value().(string)
+		if !ok {
+			return expr{}, errors.Errorf("value type %s is not applicable to %s operation", f.Value.Type(), f.Op)
+		}
+		words := strings.Fields(str)
+		if len(words) == 0 {
+			return expr{}, errors.Errorf("%s operation requires at least one word", f.Op)
+		}
+		return expr{match: fmt.Sprintf("%s : %s", col, anyOf(words))}, nil
+	case filter.CompOpNear:
+		proximity, ok := f.Value.(filter.ProximityValue)
+		if !ok {
+			return expr{}, errors.Errorf("value type %s is not applicable to %s operation", f.Value.Type(), f.Op)
+		}
+		words := strings.Fields(proximity.Phrase)
+		if len(words) == 0 {
+			return expr{}, errors.Errorf("%s operation requires at least one word", f.Op)
+		}
+		phrases := make([]string, len(words))
+		for k, w := range words {
+			phrases[k] = quote(w)
+		}
+		return expr{match: fmt.Sprintf("%s : NEAR(%s, %d)", col, strings.Join(phrases, " "), proximity.Distance)}, nil
+	case filter.CompOpWildcard:
+		// only prefix queries are supported e.g. foo*
+		pattern, ok := f.Value.Value().(string)
+		if !ok {
+			return expr{}, errors.Errorf("value type %s is not applicable to %s operation", f.Value.Type(), f.Op)
+		}
+		prefix, isPrefix := strings.CutSuffix(pattern, "*")
+		if !isPrefix || prefix == "" || strings.ContainsAny(prefix, "*?") {
+			return expr{}, errors.Errorf("%s operation only supports a single trailing wildcard", f.Op)
+		}
+		return expr{match: fmt.Sprintf("%s : %s *", col, quote(prefix))}, nil
+	case filter.CompOpIn:
+		list, ok := f.Value.(filter.ListValue)
+		if !ok {
+			return expr{}, errors.Errorf("value type %s is not applicable to %s operation", f.Value.Type(), f.Op)
+		}
+		terms := make([]string, len(list))
+		for k, v := range list {
+			term, err := termValue(f.Op, v)
+			if err != nil {
+				return expr{}, err
+			}
+			if isKey {
+				term = KeyToken(term)
+			}
+			terms[k] = term
+		}
+		if isKey {
+			return expr{match: fmt.Sprintf("%s : %s", keyCol, anyOf(terms))}, nil
+		}
+		return expr{match: fmt.Sprintf("%s : %s", col, anyOf(terms))}, nil
+	case filter.CompOpGt, filter.CompOpGe, filter.CompOpLt, filter.CompOpLe, filter.CompOpRegex:
+		return expr{}, errors.Errorf("%s operation is not supported by full text search", f.Op)
+	}
+	return expr{}, fmt.Errorf("unknown operator: %s", string(f.Op))
+}
+
+// termValue returns the value as it would appear in the indexed text.
+func termValue(op filter.CompOp, v filter.Value) (string, error) {
+	if v.IsNull() {
+		return "", errors.Errorf("null cannot be used in %s operation", op)
+	}
+	switch v.Type() {
+	case filter.StringType:
+		return v.Value().(string), nil
+	case filter.IntType, filter.FloatType, filter.BoolType:
+		return fmt.Sprintf("%v", v.Value()), nil
+	}
+	return "", errors.Errorf("value type %s is not applicable to %s operation", v.Type(), op)
+}
+
+func anyOf(terms []string) string {
+	if len(terms) == 1 {
+		return quote(terms[0])
+	}
+	quoted := make([]string, len(terms))
+	for k, t := range terms {
+		quoted[k] = quote(t)
+	}
+	return fmt.Sprintf("(%s)", strings.Join(quoted, " OR "))
+}
+
+// quote creates an FTS5 string which is matched as a phrase.
+func quote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
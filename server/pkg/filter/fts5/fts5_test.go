@@ -0,0 +1,127 @@
+package fts5
+
+import (
+	"testing"
+
+	"github.com/warmans/rsk-search/pkg/filter"
+)
+
+func TestFilterToMatch(t *testing.T) {
+
+	filterMapping := map[string]string{
+		"content": "content",
+		"actor":   "actor",
+		"series":  "series",
+	}
+	keyMapping := map[string]string{
+		"actor": "actor_key",
+	}
+
+	tests := []struct {
+		f         filter.Filter
+		wantMatch string
+		wantErr   bool
+	}{
+		// comp filters
+		{
+			f:         filter.Eq("content", filter.String(`a "quoted" phrase`)),
+			wantMatch: `content : "a ""quoted"" phrase"`,
+		},
+		{
+			f:         filter.Eq("series", filter.Int(1)),
+			wantMatch: `series : "1"`,
+		},
+		{
+			f:       filter.Eq("foo", filter.String("bar")),
+			wantErr: true,
+		},
+		{
+			f:       filter.Eq("actor", filter.Null()),
+			wantErr: true,
+		},
+		{
+			f:         filter.Like("content", filter.String("manc pies")),
+			wantMatch: `content : ("manc" OR "pies")`,
+		},
+		{
+			f:         filter.Near("content", filter.Proximity("manc pies", 3)),
+			wantMatch: `content : NEAR("manc" "pies", 3)`,
+		},
+		{
+			f:         filter.Wildcard("content", filter.String("monk*")),
+			wantMatch: `content : "monk" *`,
+		},
+		{
+			f:       filter.Wildcard("content", filter.String("m*nkey")),
+			wantErr: true,
+		},
+		{
+			f:         filter.In("actor", filter.List(filter.String("karl"), filter.String("steve"))),
+			wantMatch: `actor_key : ("k6b61726c" OR "k7374657665")`,
+		},
+		{
+			f:         filter.Like("actor", filter.String("karl")),
+			wantMatch: `actor : "karl"`,
+		},
+		{
+			f:       filter.Gt("series", filter.Int(1)),
+			wantErr: true,
+		},
+		{
+			f:       filter.Regex("content", filter.String("^ba[rz]")),
+			wantErr: true,
+		},
+		{
+			f:       filter.Neq("actor", filter.String("karl")),
+			wantErr: true,
+		},
+		// bool filters
+		{
+			f:         filter.And(filter.Eq("actor", filter.String("karl")), filter.Like("content", filter.String("monkey"))),
+			wantMatch: `(actor_key : "k6b61726c") AND (content : "monkey")`,
+		},
+		{
+			f:         filter.Or(filter.Eq("actor", filter.String("karl")), filter.Like("content", filter.String("monkey"))),
+			wantMatch: `(actor_key : "k6b61726c") OR (content : "monkey")`,
+		},
+		{
+			f:         filter.And(filter.Neq("actor", filter.String("karl")), filter.Like("content", filter.String("monkey"))),
+			wantMatch: `(content : "monkey") NOT (actor_key : "k6b61726c")`,
+		},
+		{
+			f: filter.And(
+				filter.Like("content", filter.String("monkey")),
+				filter.And(filter.Neq("actor", filter.String("karl")), filter.Neq("actor", filter.String("steve"))),
+			),
+			wantMatch: `(content : "monkey") NOT ((actor_key : "k6b61726c") OR (actor_key : "k7374657665"))`,
+		},
+		{
+			f:       filter.Or(filter.Neq("actor", filter.String("karl")), filter.Like("content", filter.String("monkey"))),
+			wantErr: true,
+		},
+		{
+			f:         filter.And(filter.Eq("actor", filter.String("karl")), filter.Not(filter.Like("content", filter.String("monkey news")))),
+			wantMatch: `(actor_key : "k6b61726c") NOT (content : ("monkey" OR "news"))`,
+		},
+		{
+			f:         filter.And(filter.Eq("actor", filter.String("karl")), filter.Not(filter.Neq("content", filter.String("monkey")))),
+			wantMatch: `(actor_key : "k6b61726c") AND (content : "monkey")`,
+		},
+		{
+			f:       filter.Not(filter.Eq("actor", filter.String("karl"))),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(filter.MustPrint(tt.f), func(t *testing.T) {
+			got, err := FilterToMatch(tt.f, filterMapping, keyMapping)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FilterToMatch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.wantMatch {
+				t.Errorf("FilterToMatch() got = %v, want %v", got, tt.wantMatch)
+			}
+		})
+	}
+}
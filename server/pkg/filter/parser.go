@@ -3,6 +3,8 @@ package filter
 import (
 	"github.com/pkg/errors"
 	"strconv"
+	"strings"
+	"time"
 )
 
 func MustParse(s string) Filter {
@@ -92,11 +94,31 @@ func (p *parser) parseInner() (Filter, error) {
 			return nil, err
 		}
 		return filter, nil
+	case tagNot:
+		filter, err := p.parseInner()
+		if err != nil {
+			return nil, err
+		}
+		return Not(filter), nil
 	case tagField:
-		op, err := p.requireNext(tagEq, tagNeq, tagLt, tagLe, tagGe, tagGt, tagLike, tagFuzzy)
+		op, err := p.requireNext(tagEq, tagNeq, tagLt, tagLe, tagGe, tagGt, tagLike, tagFuzzy, tagSound, tagNear, tagWild, tagRegex, tagIn)
 		if err != nil {
 			return nil, err
 		}
+		if op.tag == tagIn {
+			val, err := p.parseListValue()
+			if err != nil {
+				return nil, err
+			}
+			return In(token.lexeme, val), nil
+		}
+		if op.tag == tagNear {
+			val, err := p.parseProximityValue()
+			if err != nil {
+				return nil, err
+			}
+			return Near(token.lexeme, val), nil
+		}
 		val, err := p.parseValue()
 		if err != nil {
 			return nil, err
@@ -118,6 +140,12 @@ func (p *parser) parseInner() (Filter, error) {
 			return Like(token.lexeme, val), nil
 		case tagFuzzy:
 			return FuzzyLike(token.lexeme, val), nil
+		case tagSound:
+			return Phonetic(token.lexeme, val), nil
+		case tagWild:
+			return Wildcard(token.lexeme, val), nil
+		case tagRegex:
+			return Regex(token.lexeme, val), nil
 		default:
 			panic(errors.Errorf("unexpected field token '%s'", op.tag))
 		}
@@ -157,12 +185,96 @@ func (p *parser) parseValue() (Value, error) {
 			panic(errors.Wrapf(err, "failed to parse %s", token))
 		}
 		return Float(f), nil
+	case tagDuration:
+		d, err := parseDuration(token.lexeme)
+		if err != nil {
+			return nil, err
+		}
+		return Duration(d), nil
 
 	default:
 		return nil, errors.Errorf("unexpected value tag %s", token)
 	}
 }
 
+// parseProximityValue parses a phrase followed by the max distance between the words e.g. "foo bar"~5
+func (p *parser) parseProximityValue() (ProximityValue, error) {
+	phrase, err := p.requireNext(tagString)
+	if err != nil {
+		return ProximityValue{}, err
+	}
+	if _, err := p.requireNext(tagFuzzy); err != nil {
+		return ProximityValue{}, err
+	}
+	distance, err := p.requireNext(tagInt)
+	if err != nil {
+		return ProximityValue{}, err
+	}
+	i, err := strconv.ParseInt(distance.lexeme, 10, 64)
+	if err != nil {
+		return ProximityValue{}, errors.Wrapf(err, "failed to parse %s", distance.tag)
+	}
+	if i < 0 {
+		return ProximityValue{}, errors.Errorf("proximity distance cannot be negative")
+	}
+	return Proximity(phrase.lexeme, i), nil
+}
+
+// parseListValue parses a non-empty list of values e.g. ["foo", "bar"]
+func (p *parser) parseListValue() (ListValue, error) {
+	if _, err := p.requireNext(tagLBracket); err != nil {
+		return nil, err
+	}
+	list := ListValue{}
+	for {
+		val, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, val)
+		sep, err := p.requireNext(tagComma, tagRBracket)
+		if err != nil {
+			return nil, err
+		}
+		if sep.tag == tagRBracket {
+			return list, nil
+		}
+	}
+}
+
+// parseDuration accepts either a Go style duration e.g. 1h30m or a clock style duration e.g. 01:30:00 or 90:00
+func parseDuration(s string) (time.Duration, error) {
+	if !strings.Contains(s, ":") {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid duration %s", s)
+		}
+		return d, nil
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0, errors.Errorf("invalid duration %s: expected [hh:]mm:ss", s)
+	}
+	var d time.Duration
+	for k, part := range parts {
+		// only the seconds may have a fractional part
+		if k < len(parts)-1 {
+			v, err := strconv.ParseUint(part, 10, 64)
+			if err != nil {
+				return 0, errors.Wrapf(err, "invalid duration %s", s)
+			}
+			d = (d + time.Duration(v)*time.Second) * 60
+			continue
+		}
+		v, err := strconv.ParseFloat(part, 64)
+		if err != nil || v < 0 {
+			return 0, errors.Errorf("invalid duration %s: expected [hh:]mm:ss", s)
+		}
+		d += time.Duration(v * float64(time.Second))
+	}
+	return d, nil
+}
+
 // peekNext gets the next token without advancing.
 func (p *parser) peekNext() (token, error) {
 	if p.peeked != nil {
@@ -3,6 +3,7 @@ package filter
 import (
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestParseCompFilter(t *testing.T) {
@@ -52,6 +53,68 @@ func TestParseCompFilter(t *testing.T) {
 		`foo ~ "bar"`: {
 			expectFilter: FuzzyLike("foo", String("bar")),
 		},
+		`foo ~p "bar"`: {
+			expectFilter: Phonetic("foo", String("bar")),
+		},
+		`foo near "bar baz"~5`: {
+			expectFilter: Near("foo", Proximity("bar baz", 5)),
+		},
+		`foo near "bar baz"`: {
+			expectError: true, // missing distance
+		},
+		`foo near 1`: {
+			expectError: true,
+		},
+		`foo ~* "bar*"`: {
+			expectFilter: Wildcard("foo", String("bar*")),
+		},
+		`foo =~ "ba[rz]"`: {
+			expectFilter: Regex("foo", String("ba[rz]")),
+		},
+		// lists
+		`foo in ["bar", "baz"]`: {
+			expectFilter: In("foo", List(String("bar"), String("baz"))),
+		},
+		`foo in [1]`: {
+			expectFilter: In("foo", List(Int(1))),
+		},
+		`foo in []`: {
+			expectError: true,
+		},
+		`foo in ["bar" "baz"]`: {
+			expectError: true, // missing comma
+		},
+		`foo in ["bar",]`: {
+			expectError: true,
+		},
+		`foo in "bar"`: {
+			expectError: true,
+		},
+		// durations
+		`foo >= 45m`: {
+			expectFilter: Ge("foo", Duration(time.Minute*45)),
+		},
+		`foo < 1h30m10.5s`: {
+			expectFilter: Lt("foo", Duration(time.Hour+time.Minute*30+time.Second*10+time.Millisecond*500)),
+		},
+		`foo >= 00:45:00`: {
+			expectFilter: Ge("foo", Duration(time.Minute*45)),
+		},
+		`foo >= 90:30.5`: {
+			expectFilter: Ge("foo", Duration(time.Minute*90+time.Second*30+time.Millisecond*500)),
+		},
+		`foo >= 10x`: {
+			expectError: true, // not a known unit
+		},
+		`foo >= 45mm`: {
+			expectError: true,
+		},
+		`foo >= 1.5:00`: {
+			expectError: true, // only seconds can be fractional
+		},
+		`foo >= 1:2:3:4`: {
+			expectError: true,
+		},
 	}
 	for condition, test := range tests {
 		t.Run(condition, func(t *testing.T) {
@@ -84,6 +147,12 @@ func TestParseBoolFilter(t *testing.T) {
 		`((foo = true) or (bar = false)) and baz = 1.0`: {
 			expectFilter: And(Or(Eq("foo", Bool(true)), Eq("bar", Bool(false))), Eq("baz", Float(1.0))),
 		},
+		`not foo ~ "bar" and baz = 1`: {
+			expectFilter: And(Not(FuzzyLike("foo", String("bar"))), Eq("baz", Int(1))),
+		},
+		`not (foo = true or bar = false)`: {
+			expectFilter: Not(Or(Eq("foo", Bool(true)), Eq("bar", Bool(false)))),
+		},
 	}
 	for condition, test := range tests {
 		t.Run(condition, func(t *testing.T) {
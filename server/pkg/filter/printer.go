@@ -82,3 +82,26 @@ func (p *Printer) VisitBoolFilter(filter *BoolFilter) (Visitor, error) {
 
 	return nil, nil
 }
+
+func (p *Printer) VisitNotFilter(filter *NotFilter) (Visitor, error) {
+
+	needsParen := filter.Filter.Precedence() < filter.Precedence()
+
+	if _, err := fmt.Fprint(p.w, "not "); err != nil {
+		return nil, err
+	}
+	if needsParen {
+		if _, err := fmt.Fprint(p.w, `(`); err != nil {
+			return nil, err
+		}
+	}
+	if err := filter.Filter.Accept(p); err != nil {
+		return nil, err
+	}
+	if needsParen {
+		if _, err := fmt.Fprint(p.w, `)`); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestPrint(t *testing.T) {
@@ -28,6 +29,26 @@ func TestPrint(t *testing.T) {
 			filter:       And(Eq("foo", String("bar")), Or(Gt("bar", Int(1)), Neq("baz", Int(2)))),
 			expectString: `foo = "bar" and (bar > 1 or baz != 2)`,
 			expectError:  false,
+		}, {
+			filter:       Near("foo", Proximity("bar baz", 3)),
+			expectString: `foo near "bar baz"~3`,
+			expectError:  false,
+		}, {
+			filter:       Ge("foo", Duration(time.Minute*45)),
+			expectString: `foo >= 45m0s`,
+			expectError:  false,
+		}, {
+			filter:       Wildcard("foo", String("bar*")),
+			expectString: `foo ~* "bar*"`,
+			expectError:  false,
+		}, {
+			filter:       In("foo", List(String("bar"), Int(1))),
+			expectString: `foo in ["bar", 1]`,
+			expectError:  false,
+		}, {
+			filter:       And(Not(Like("foo", String("bar"))), Not(Or(Eq("foo", String("baz")), Gt("bar", Int(1))))),
+			expectString: `not foo ~= "bar" and not (foo = "baz" or bar > 1)`,
+			expectError:  false,
 		},
 	}
 
@@ -45,7 +66,7 @@ func TestPrint(t *testing.T) {
 }
 
 func TestParsePrinted(t *testing.T) {
-	in := And(Eq("foo", String("bar")), Or(Gt("bar", Int(1)), Neq("baz", Int(2))))
+	in := And(Eq("foo", String("bar")), Or(Gt("bar", Int(1)), Neq("baz", Int(2))), Near("foo", Proximity("bar baz", 3)), Lt("offset", Duration(time.Minute*90+time.Millisecond*500)), In("actor", List(String("karl"), String("steve"))), Regex("content", String("pil+k")), Not(FuzzyLike("content", String("pilk"))))
 	out := MustParse(MustPrint(in))
 	require.EqualValues(t, in, out)
 }
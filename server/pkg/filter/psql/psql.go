@@ -7,19 +7,33 @@ import (
 	"strings"
 )
 
+// Dialect is the database the SQL is generated for. Only regex matching differs between them.
+type Dialect string
+
+const (
+	DialectPostgres Dialect = "postgres"
+	// DialectSQLite requires a REGEXP function to be registered with the driver.
+	DialectSQLite Dialect = "sqlite"
+)
+
 func FilterToQuery(f filter.Filter, filterMapping map[string]string) (string, []interface{}, error) {
-	v := &visitor{filterMapping: filterMapping, params: newParams()}
+	return FilterToDialectQuery(DialectPostgres, f, filterMapping)
+}
+
+func FilterToDialectQuery(dialect Dialect, f filter.Filter, filterMapping map[string]string) (string, []interface{}, error) {
+	v := &visitor{dialect: dialect, filterMapping: filterMapping, params: newParams()}
 	err := f.Accept(v)
 	return v.sql, v.params.par, err
 }
 
-func filterAppendQuery(f filter.Filter, filterMapping map[string]string, params *params) (string, []interface{}, error) {
-	v := &visitor{filterMapping: filterMapping, params: params}
+func filterAppendQuery(dialect Dialect, f filter.Filter, filterMapping map[string]string, params *params) (string, []interface{}, error) {
+	v := &visitor{dialect: dialect, filterMapping: filterMapping, params: params}
 	err := f.Accept(v)
 	return v.sql, v.params.par, err
 }
 
 type visitor struct {
+	dialect       Dialect
 	filterMapping map[string]string
 
 	sql    string
@@ -27,37 +41,48 @@ type visitor struct {
 }
 
 func (j *visitor) VisitCompFilter(f *filter.CompFilter) (filter.Visitor, error) {
-	qb := newQueryBuilder(j.filterMapping)
+	qb := newQueryBuilder(j.dialect, j.filterMapping)
 	var err error
 	j.sql, err = qb.compExpr(f, j.params)
 	return nil, err
 }
 
 func (j *visitor) VisitBoolFilter(f *filter.BoolFilter) (filter.Visitor, error) {
-	qb := newQueryBuilder(j.filterMapping)
+	qb := newQueryBuilder(j.dialect, j.filterMapping)
 	var err error
 	j.sql, err = qb.boolExpr(f, j.params)
 	return nil, err
 }
 
-func newQueryBuilder(filterMapping map[string]string) *queryBuilder {
+func (j *visitor) VisitNotFilter(f *filter.NotFilter) (filter.Visitor, error) {
+	inner, _, err := filterAppendQuery(j.dialect, f.Filter, j.filterMapping, j.params)
+	if err != nil {
+		return nil, err
+	}
+	j.sql = fmt.Sprintf("NOT (%s)", inner)
+	return nil, nil
+}
+
+func newQueryBuilder(dialect Dialect, filterMapping map[string]string) *queryBuilder {
 	return &queryBuilder{
+		dialect:        dialect,
 		filterToSelect: filterMapping,
 	}
 }
 
 type queryBuilder struct {
+	dialect        Dialect
 	filterToSelect map[string]string
 }
 
 func (j *queryBuilder) boolExpr(f *filter.BoolFilter, p *params) (string, error) {
 
-	lhs, _, err := filterAppendQuery(f.LHS, j.filterToSelect, p)
+	lhs, _, err := filterAppendQuery(j.dialect, f.LHS, j.filterToSelect, p)
 	if err != nil {
 		return "", err
 	}
 
-	rhs, _, err := filterAppendQuery(f.RHS, j.filterToSelect, p)
+	rhs, _, err := filterAppendQuery(j.dialect, f.RHS, j.filterToSelect, p)
 	if err != nil {
 		return "", err
 	}
@@ -95,10 +120,43 @@ func (j *queryBuilder) compExpr(f *filter.CompFilter, p *params) (string, error)
 	case filter.CompOpFuzzyLike:
 		// could make this better if proper fuzzy search is needed.
 		return fmt.Sprintf(`%s ILIKE %s`, col, p.next(fmt.Sprintf("%%%s%%", strings.Trim(f.Value.String(), `"`)))), nil
+	case filter.CompOpWildcard:
+		pattern, ok := f.Value.Value().(string)
+		if !ok {
+			return "", errors.Errorf("value type %s is not applicable to %s operation", f.Value.Type(), f.Op)
+		}
+		return fmt.Sprintf(`%s LIKE %s ESCAPE '\'`, col, p.next(wildcardToLike(pattern))), nil
+	case filter.CompOpRegex:
+		pattern, ok := f.Value.Value().(string)
+		if !ok {
+			return "", errors.Errorf("value type %s is not applicable to %s operation", f.Value.Type(), f.Op)
+		}
+		if j.dialect == DialectSQLite {
+			return fmt.Sprintf(`%s REGEXP %s`, col, p.next(pattern)), nil
+		}
+		return fmt.Sprintf(`%s ~ %s`, col, p.next(pattern)), nil
+	case filter.CompOpIn:
+		list, ok := f.Value.(filter.ListValue)
+		if !ok {
+			return "", errors.Errorf("value type %s is not applicable to %s operation", f.Value.Type(), f.Op)
+		}
+		placeholders := make([]string, len(list))
+		for k, v := range list {
+			if v.IsNull() {
+				return "", errors.Errorf("null cannot be used in %s operation", f.Op)
+			}
+			placeholders[k] = p.next(v.Value())
+		}
+		return fmt.Sprintf(`%s IN (%s)`, col, strings.Join(placeholders, ", ")), nil
 	}
 	return "", fmt.Errorf("unknown operator: %s", string(f.Op))
 }
 
+// wildcardToLike converts * and ? wildcards to the LIKE equivalents, escaping any existing LIKE wildcards.
+func wildcardToLike(pattern string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`, `*`, `%`, `?`, `_`).Replace(pattern)
+}
+
 func newParams() *params {
 	return &params{par: []interface{}{}}
 }
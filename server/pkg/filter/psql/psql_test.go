@@ -6,6 +6,21 @@ import (
 	"testing"
 )
 
+func TestFilterToDialectQuery(t *testing.T) {
+	filterMapping := map[string]string{"foo": "foo"}
+
+	got, params, err := FilterToDialectQuery(DialectSQLite, filter.Not(filter.Regex("foo", filter.String("^ba[rz]"))), filterMapping)
+	if err != nil {
+		t.Fatalf("FilterToDialectQuery() error = %v", err)
+	}
+	if got != "NOT (foo REGEXP $1)" {
+		t.Errorf("FilterToDialectQuery() got = %v, want %v", got, "NOT (foo REGEXP $1)")
+	}
+	if !reflect.DeepEqual(params, []interface{}{"^ba[rz]"}) {
+		t.Errorf("FilterToDialectQuery() params = %v, want %v", params, []interface{}{"^ba[rz]"})
+	}
+}
+
 func TestFilterToQuery1(t *testing.T) {
 
 	filterMapping := map[string]string{
@@ -87,6 +102,30 @@ func TestFilterToQuery1(t *testing.T) {
 			wantParams: []interface{}{"%bar%"},
 			wantErr:    false,
 		},
+		{
+			f:          filter.Wildcard("foo", filter.String("b*r?_%")),
+			wantSQL:    `foo LIKE $1 ESCAPE '\'`,
+			wantParams: []interface{}{`b%r_\_\%`},
+			wantErr:    false,
+		},
+		{
+			f:          filter.Regex("foo", filter.String("^ba[rz]")),
+			wantSQL:    "foo ~ $1",
+			wantParams: []interface{}{"^ba[rz]"},
+			wantErr:    false,
+		},
+		{
+			f:          filter.In("foo", filter.List(filter.String("bar"), filter.String("baz"))),
+			wantSQL:    "foo IN ($1, $2)",
+			wantParams: []interface{}{"bar", "baz"},
+			wantErr:    false,
+		},
+		{
+			f:          filter.In("foo", filter.List(filter.Null())),
+			wantSQL:    "",
+			wantParams: []interface{}{},
+			wantErr:    true,
+		},
 		// bool filters
 		{
 			f:          filter.And(filter.Eq("foo", filter.String("dog")), filter.Eq("baz", filter.String("cat"))),
@@ -112,6 +151,12 @@ func TestFilterToQuery1(t *testing.T) {
 			wantParams: []interface{}{"dog", "cat", "dog", "cat"},
 			wantErr:    false,
 		},
+		{
+			f:          filter.And(filter.Eq("foo", filter.String("dog")), filter.Not(filter.Like("baz", filter.String("cat")))),
+			wantSQL:    "(foo = $1) and (NOT (baz LIKE $2))",
+			wantParams: []interface{}{"dog", "%cat%"},
+			wantErr:    false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(filter.MustPrint(tt.f), func(t *testing.T) {
@@ -14,32 +14,46 @@ const (
 	tagLParen tag = "("
 	tagRParen tag = ")"
 
+	tagLBracket tag = "["
+	tagRBracket tag = "]"
+	tagComma    tag = ","
+
 	tagAnd tag = "AND"
 	tagOr  tag = "OR"
+	tagNot tag = "NOT"
 
 	tagEq    tag = "="
 	tagNeq   tag = "!="
 	tagLike  tag = "~="
 	tagFuzzy tag = "~"
+	tagSound tag = "~p"
 	tagGt    tag = ">"
 	tagGe    tag = ">="
 	tagLe    tag = "<="
 	tagLt    tag = "<"
-
-	tagField  tag = "FIELD"
-	tagInt    tag = "INT"
-	tagFloat  tag = "FLOAT"
-	tagBool   tag = "BOOL"
-	tagString tag = "STRING"
-	tagNull   tag = "NULL"
+	tagNear  tag = "NEAR"
+	tagWild  tag = "~*"
+	tagRegex tag = "=~"
+	tagIn    tag = "IN"
+
+	tagField    tag = "FIELD"
+	tagInt      tag = "INT"
+	tagFloat    tag = "FLOAT"
+	tagDuration tag = "DURATION"
+	tagBool     tag = "BOOL"
+	tagString   tag = "STRING"
+	tagNull     tag = "NULL"
 )
 
 var keywords = map[string]tag{
 	"and":   tagAnd,
 	"or":    tagOr,
+	"not":   tagNot,
 	"true":  tagBool,
 	"false": tagBool,
 	"null":  tagNull,
+	"near":  tagNear,
+	"in":    tagIn,
 }
 
 type token struct {
@@ -101,7 +115,16 @@ func (s *scanner) next() (token, error) {
 		return s.emit(tagLParen), nil
 	case ')':
 		return s.emit(tagRParen), nil
+	case '[':
+		return s.emit(tagLBracket), nil
+	case ']':
+		return s.emit(tagRBracket), nil
+	case ',':
+		return s.emit(tagComma), nil
 	case '=':
+		if s.matchNextRune('~') {
+			return s.emit(tagRegex), nil
+		}
 		return s.emit(tagEq), nil
 	case '!':
 		if s.matchNextRune('=') {
@@ -112,6 +135,12 @@ func (s *scanner) next() (token, error) {
 		if s.matchNextRune('=') {
 			return s.emit(tagLike), nil
 		}
+		if s.matchNextRune('*') {
+			return s.emit(tagWild), nil
+		}
+		if s.matchNextRune('p') {
+			return s.emit(tagSound), nil
+		}
 		return s.emit(tagFuzzy), nil
 	case '>':
 		if s.matchNextRune('=') {
@@ -179,6 +208,13 @@ func (s *scanner) scanNumber() token {
 		r := s.nextRune()
 		hasDecimal = hasDecimal || r == '.'
 	}
+	// a number followed by a unit or colon is a duration e.g. 45m, 1h30m or 00:45:00
+	if !s.atEOF() && (isDurationRune(s.peekRune()) || s.peekRune() == ':') {
+		for !s.atEOF() && (isNumber(s.peekRune()) || isDurationRune(s.peekRune()) || s.peekRune() == ':' || s.peekRune() == '.') {
+			s.nextRune()
+		}
+		return s.emit(tagDuration)
+	}
 	if hasDecimal {
 		return s.emit(tagFloat)
 	}
@@ -221,6 +257,10 @@ func isNumber(r rune) bool {
 	return r >= '0' && r <= '9'
 }
 
+func isDurationRune(r rune) bool {
+	return strings.ContainsRune("hmsuµn", r)
+}
+
 func isValidFieldRune(r rune) bool {
 	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r == '_'
 }
@@ -151,6 +151,14 @@ func TestScan(t *testing.T) {
 				{tag: tagEOF},
 			},
 		},
+		`foo ~p "bar"`: {
+			expectTokens: []token{
+				{tag: tagField, lexeme: "foo"},
+				{tag: tagSound, lexeme: "~p"},
+				{tag: tagString, lexeme: "bar"},
+				{tag: tagEOF},
+			},
+		},
 		`foo ~= "bar"`: {
 			expectTokens: []token{
 				{tag: tagField, lexeme: "foo"},
@@ -159,10 +167,64 @@ func TestScan(t *testing.T) {
 				{tag: tagEOF},
 			},
 		},
+		`foo near "bar baz"~5`: {
+			expectTokens: []token{
+				{tag: tagField, lexeme: "foo"},
+				{tag: tagNear, lexeme: "near"},
+				{tag: tagString, lexeme: "bar baz"},
+				{tag: tagFuzzy, lexeme: "~"},
+				{tag: tagInt, lexeme: "5"},
+				{tag: tagEOF},
+			},
+		},
+		"foo >= 45m": {
+			expectTokens: []token{
+				{tag: tagField, lexeme: "foo"},
+				{tag: tagGe, lexeme: ">="},
+				{tag: tagDuration, lexeme: "45m"},
+				{tag: tagEOF},
+			},
+		},
+		"foo < 00:45:00": {
+			expectTokens: []token{
+				{tag: tagField, lexeme: "foo"},
+				{tag: tagLt, lexeme: "<"},
+				{tag: tagDuration, lexeme: "00:45:00"},
+				{tag: tagEOF},
+			},
+		},
 		`foo = "bar`: {
 			expectError: true, // unclosed quote
 		},
 
+		"foo ~* \"bar*\"": {
+			expectTokens: []token{
+				{tag: tagField, lexeme: "foo"},
+				{tag: tagWild, lexeme: "~*"},
+				{tag: tagString, lexeme: "bar*"},
+				{tag: tagEOF},
+			},
+		},
+		"foo =~ \"ba.\"": {
+			expectTokens: []token{
+				{tag: tagField, lexeme: "foo"},
+				{tag: tagRegex, lexeme: "=~"},
+				{tag: tagString, lexeme: "ba."},
+				{tag: tagEOF},
+			},
+		},
+		"foo in [\"bar\", 1]": {
+			expectTokens: []token{
+				{tag: tagField, lexeme: "foo"},
+				{tag: tagIn, lexeme: "in"},
+				{tag: tagLBracket, lexeme: "["},
+				{tag: tagString, lexeme: "bar"},
+				{tag: tagComma, lexeme: ","},
+				{tag: tagInt, lexeme: "1"},
+				{tag: tagRBracket, lexeme: "]"},
+				{tag: tagEOF},
+			},
+		},
 	}
 
 	for str, test := range tests {
@@ -3,11 +3,14 @@ package filter
 type Type string
 
 const (
-	NullType    Type = "null"
-	IntType     Type = "int"
-	FloatType   Type = "float"
-	StringType  Type = "string"
-	BoolType    Type = "bool"
+	NullType      Type = "null"
+	IntType       Type = "int"
+	FloatType     Type = "float"
+	StringType    Type = "string"
+	BoolType      Type = "bool"
+	ProximityType Type = "proximity"
+	DurationType  Type = "duration"
+	ListType      Type = "list"
 )
 
 func (t Type) Kind() Type {
@@ -1,6 +1,10 @@
 package filter
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
 
 type Value interface {
 	// Type returns the type for the value.
@@ -122,3 +126,83 @@ func (s FloatValue) Value() interface{} {
 func (s FloatValue) String() string {
 	return fmt.Sprint(float64(s))
 }
+
+func Duration(v time.Duration) DurationValue {
+	return DurationValue(v)
+}
+
+// DurationValue is a time offset e.g. 45m or 00:45:00
+type DurationValue time.Duration
+
+func (d DurationValue) Type() Type {
+	return DurationType
+}
+
+func (d DurationValue) IsNull() bool {
+	return false
+}
+
+func (d DurationValue) Value() interface{} {
+	return time.Duration(d)
+}
+
+func (d DurationValue) String() string {
+	return time.Duration(d).String()
+}
+
+func Proximity(phrase string, distance int64) ProximityValue {
+	return ProximityValue{Phrase: phrase, Distance: distance}
+}
+
+// ProximityValue is a phrase where the words may be up to Distance positions apart e.g. "foo bar"~5
+type ProximityValue struct {
+	Phrase   string
+	Distance int64
+}
+
+func (p ProximityValue) Type() Type {
+	return ProximityType
+}
+
+func (p ProximityValue) IsNull() bool {
+	return false
+}
+
+func (p ProximityValue) Value() interface{} {
+	return p.Phrase
+}
+
+func (p ProximityValue) String() string {
+	return fmt.Sprintf(`"%s"~%d`, p.Phrase, p.Distance)
+}
+
+func List(values ...Value) ListValue {
+	return ListValue(values)
+}
+
+// ListValue is a set of values e.g. ["foo", "bar"]
+type ListValue []Value
+
+func (l ListValue) Type() Type {
+	return ListType
+}
+
+func (l ListValue) IsNull() bool {
+	return false
+}
+
+func (l ListValue) Value() interface{} {
+	values := make([]interface{}, len(l))
+	for k, v := range l {
+		values[k] = v.Value()
+	}
+	return values
+}
+
+func (l ListValue) String() string {
+	values := make([]string, len(l))
+	for k, v := range l {
+		values[k] = v.String()
+	}
+	return fmt.Sprintf("[%s]", strings.Join(values, ", "))
+}
@@ -0,0 +1,143 @@
+package frequency
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/data"
+	"github.com/warmans/rsk-search/pkg/models"
+)
+
+// PerWords is the number of words frequencies are relative to.
+const PerWords = 10000
+
+var wordRegex = regexp.MustCompile(`[\p{L}\p{N}']+`)
+
+type EpisodeGetter interface {
+	GetEpisode(id string, deepCopy bool) (*models.Transcript, error)
+}
+
+// Words splits the text into lowercase words ignoring punctuation.
+func Words(text string) []string {
+	words := []string{}
+	// transcripts use both straight and curly apostrophes.
+	for _, w := range wordRegex.FindAllString(strings.ToLower(strings.ReplaceAll(text, "’", "'")), -1) {
+		if w = strings.Trim(w, "'"); w != "" {
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+// TermFrequency counts how many times each term was said in each of the episodes. Terms may be phrases but
+// cannot span multiple lines. Episodes that do not exist are treated as empty.
+func TermFrequency(episodes EpisodeGetter, episodeIDs []string, terms []string, byActor bool) (*api.TermFrequency, error) {
+	termWords := make([][]string, len(terms))
+	for k, t := range terms {
+		if termWords[k] = Words(t); len(termWords[k]) == 0 {
+			return nil, errors.Errorf("term contains no words: %s", t)
+		}
+	}
+
+	// counts are per term, per actor (or "" if not by actor), per episode.
+	counts := make([]map[string][]int32, len(terms))
+	for k := range counts {
+		counts[k] = map[string][]int32{}
+	}
+	// totals are the number of words said per actor per episode.
+	totals := map[string][]int32{}
+
+	for epIdx, epID := range episodeIDs {
+		ep, err := episodes.GetEpisode(epID, false)
+		if err != nil {
+			if errors.Is(err, data.ErrNotFound) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to get episode %s", epID)
+		}
+		for _, d := range ep.Transcript {
+			if d.Type != models.DialogTypeChat {
+				continue
+			}
+			actor := ""
+			if byActor {
+				if actor = d.Actor; actor == "" {
+					continue
+				}
+			}
+			lineWords := Words(d.Content)
+			if _, ok := totals[actor]; !ok {
+				totals[actor] = make([]int32, len(episodeIDs))
+			}
+			totals[actor][epIdx] += int32(len(lineWords))
+
+			for termIdx, tw := range termWords {
+				if n := countOccurrences(lineWords, tw); n > 0 {
+					if _, ok := counts[termIdx][actor]; !ok {
+						counts[termIdx][actor] = make([]int32, len(episodeIDs))
+					}
+					counts[termIdx][actor][epIdx] += n
+				}
+			}
+		}
+	}
+
+	res := &api.TermFrequency{EpisodeIds: episodeIDs, Series: []*api.TermFrequencySeries{}}
+	for termIdx, term := range terms {
+		actors := []string{""}
+		if byActor {
+			// only actors that said the term are included.
+			actors = sortedActors(counts[termIdx])
+		}
+		for _, actor := range actors {
+			series := &api.TermFrequencySeries{
+				Term:        term,
+				Actor:       actor,
+				Counts:      make([]int32, len(episodeIDs)),
+				Frequencies: make([]float32, len(episodeIDs)),
+			}
+			if c, ok := counts[termIdx][actor]; ok {
+				series.Counts = c
+			}
+			for epIdx, count := range series.Counts {
+				if total := totals[actor]; total != nil && total[epIdx] > 0 {
+					series.Frequencies[epIdx] = float32(float64(count) / float64(total[epIdx]) * PerWords)
+				}
+			}
+			res.Series = append(res.Series, series)
+		}
+	}
+	return res, nil
+}
+
+func countOccurrences(words []string, term []string) int32 {
+	count := int32(0)
+	for i := 0; i+len(term) <= len(words); i++ {
+		if slices.Equal(words[i:i+len(term)], term) {
+			count++
+		}
+	}
+	return count
+}
+
+// sortedActors orders the actors by their total count, highest first.
+func sortedActors(counts map[string][]int32) []string {
+	totals := map[string]int32{}
+	actors := []string{}
+	for actor, c := range counts {
+		actors = append(actors, actor)
+		for _, v := range c {
+			totals[actor] += v
+		}
+	}
+	slices.SortFunc(actors, func(a, b string) int {
+		if totals[a] != totals[b] {
+			return int(totals[b] - totals[a])
+		}
+		return strings.Compare(a, b)
+	})
+	return actors
+}
@@ -0,0 +1,64 @@
+package frequency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/data"
+	"github.com/warmans/rsk-search/pkg/models"
+	"github.com/warmans/rsk-search/pkg/models/modelstest"
+)
+
+type testEpisodes map[string]*models.Transcript
+
+func (t testEpisodes) GetEpisode(id string, deepCopy bool) (*models.Transcript, error) {
+	if ep, ok := t[id]; ok {
+		return ep, nil
+	}
+	return nil, data.ErrNotFound
+}
+
+func TestWords(t *testing.T) {
+	require.EqualValues(t, []string{"i'm", "a", "little", "monkey", "ey"}, Words(`I'm a little... MONKEY, 'ey!`))
+	require.EqualValues(t, []string{"don't", "say", "that"}, Words(`"'Don't' say that"`))
+	require.EqualValues(t, []string{"it's", "not"}, Words(`It’s not`))
+}
+
+func TestTermFrequency(t *testing.T) {
+	episodes := testEpisodes{
+		"xfm-S1E01": modelstest.Transcript(
+			1,
+			[2]string{"karl", "a little monkey, a little monkey"},
+			[2]string{"ricky", "what about the monkey"},
+		),
+		"xfm-S1E02": modelstest.Transcript(
+			2,
+			[2]string{"karl", "nothing"},
+		),
+	}
+	episodeIDs := []string{"xfm-S1E01", "xfm-S1E02", "xfm-S1E03"}
+
+	res, err := TermFrequency(episodes, episodeIDs, []string{"monkey", "Little Monkey"}, false)
+	require.NoError(t, err)
+	require.EqualValues(t, episodeIDs, res.EpisodeIds)
+	require.Len(t, res.Series, 2)
+	require.EqualValues(t, &api.TermFrequencySeries{
+		Term:        "monkey",
+		Counts:      []int32{3, 0, 0},
+		Frequencies: []float32{3.0 / 10 * PerWords, 0, 0},
+	}, res.Series[0])
+	require.EqualValues(t, []int32{2, 0, 0}, res.Series[1].Counts)
+
+	res, err = TermFrequency(episodes, episodeIDs, []string{"monkey"}, true)
+	require.NoError(t, err)
+	require.Len(t, res.Series, 2)
+	require.EqualValues(t, "karl", res.Series[0].Actor)
+	require.EqualValues(t, []int32{2, 0, 0}, res.Series[0].Counts)
+	require.EqualValues(t, []float32{2.0 / 6 * PerWords, 0, 0}, res.Series[0].Frequencies)
+	require.EqualValues(t, "ricky", res.Series[1].Actor)
+	require.EqualValues(t, []float32{1.0 / 4 * PerWords, 0, 0}, res.Series[1].Frequencies)
+
+	_, err = TermFrequency(episodes, episodeIDs, []string{"..."}, false)
+	require.Error(t, err)
+}
@@ -0,0 +1,176 @@
+package frequency
+
+import (
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/blugelabs/bluge/analysis/lang/en"
+	"github.com/pkg/errors"
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/data"
+	"github.com/warmans/rsk-search/pkg/models"
+)
+
+var stopWords = en.StopWords()
+
+// fillerWords are common in speech but not in the written text the stop words are based on.
+var fillerWords = map[string]bool{"yeah": true, "oh": true, "um": true, "uh": true, "er": true, "erm": true, "ah": true, "okay": true, "ok": true, "mm": true}
+
+// Scope limits the lines phrases are taken from. Empty fields are ignored.
+type Scope struct {
+	Actor       string
+	Publication string
+	Series      int32
+}
+
+type PhraseOptions struct {
+	MinWords int
+	MaxWords int
+	// MinCount excludes rare phrases which would otherwise be over-scored by PMI.
+	MinCount   int32
+	MaxResults int
+	Scoring    api.ListPhrasesRequest_Scoring
+}
+
+// Phrases finds the highest scoring phrases said in the episodes. Phrases cannot span multiple lines.
+func Phrases(episodes EpisodeGetter, episodeIDs []string, scope Scope, opts PhraseOptions) ([]*api.Phrase, error) {
+	if opts.MinWords < 2 || opts.MaxWords < opts.MinWords {
+		return nil, errors.Errorf("invalid phrase length %d-%d", opts.MinWords, opts.MaxWords)
+	}
+	lines := [][]string{}
+	for _, epID := range episodeIDs {
+		ep, err := episodes.GetEpisode(epID, false)
+		if err != nil {
+			if errors.Is(err, data.ErrNotFound) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to get episode %s", epID)
+		}
+		if (scope.Publication != "" && ep.Publication != scope.Publication) || (scope.Series != 0 && ep.Series != scope.Series) {
+			continue
+		}
+		for _, d := range ep.Transcript {
+			if d.Type != models.DialogTypeChat || (scope.Actor != "" && d.Actor != scope.Actor) {
+				continue
+			}
+			lines = append(lines, Words(d.Content))
+		}
+	}
+	return scorePhrases(lines, opts), nil
+}
+
+func scorePhrases(lines [][]string, opts PhraseOptions) []*api.Phrase {
+	// counts are indexed by the number of words in the n-gram.
+	counts := make([]map[string]int32, opts.MaxWords+1)
+	counts[1] = map[string]int32{}
+	total := 0
+	for _, line := range lines {
+		for _, w := range line {
+			counts[1][w]++
+		}
+		total += len(line)
+	}
+	// an n-gram can only be frequent if the n-1 grams it contains are also frequent so only those need to be counted.
+	for n := 2; n <= opts.MaxWords; n++ {
+		counts[n] = map[string]int32{}
+		for _, line := range lines {
+			for i := 0; i+n <= len(line); i++ {
+				if counts[n-1][strings.Join(line[i:i+n-1], " ")] < opts.MinCount || counts[n-1][strings.Join(line[i+1:i+n], " ")] < opts.MinCount {
+					continue
+				}
+				counts[n][strings.Join(line[i:i+n], " ")]++
+			}
+		}
+	}
+
+	phrases := []*api.Phrase{}
+	for n := opts.MinWords; n <= opts.MaxWords; n++ {
+		for phrase, count := range counts[n] {
+			if count < opts.MinCount {
+				continue
+			}
+			words := strings.Split(phrase, " ")
+			if allStopWords(words) {
+				continue
+			}
+			p := &api.Phrase{Phrase: phrase, Count: count}
+			switch opts.Scoring {
+			case api.ListPhrasesRequest_COUNT:
+				p.Score = float32(count)
+			case api.ListPhrasesRequest_PMI:
+				p.Score = float32(weakestSplit(words, counts, total, pmi))
+			default:
+				p.Score = float32(weakestSplit(words, counts, total, logLikelihood))
+			}
+			phrases = append(phrases, p)
+		}
+	}
+	slices.SortFunc(phrases, func(a, b *api.Phrase) int {
+		if a.Score != b.Score {
+			if a.Score > b.Score {
+				return -1
+			}
+			return 1
+		}
+		if a.Count != b.Count {
+			return int(b.Count - a.Count)
+		}
+		return strings.Compare(a.Phrase, b.Phrase)
+	})
+	if len(phrases) > opts.MaxResults {
+		phrases = phrases[:opts.MaxResults]
+	}
+	return phrases
+}
+
+func allStopWords(words []string) bool {
+	for _, w := range words {
+		if !stopWords[w] && !fillerWords[w] {
+			return false
+		}
+	}
+	return true
+}
+
+// weakestSplit scores each way the phrase can be split into two parts and returns the lowest score. A phrase
+// is only as strong as its weakest link e.g. "the little monkey" would be penalized if "the" is not
+// strongly associated with "little monkey".
+func weakestSplit(words []string, counts []map[string]int32, total int, score func(xy, x, y, n float64) float64) float64 {
+	xy := float64(counts[len(words)][strings.Join(words, " ")])
+	lowest := math.Inf(1)
+	for i := 1; i < len(words); i++ {
+		x := float64(counts[i][strings.Join(words[:i], " ")])
+		y := float64(counts[len(words)-i][strings.Join(words[i:], " ")])
+		lowest = min(lowest, score(xy, x, y, float64(total)))
+	}
+	return lowest
+}
+
+// pmi is the pointwise mutual information of x and y occurring together.
+func pmi(xy, x, y, n float64) float64 {
+	return math.Log2((xy * n) / (x * y))
+}
+
+// logLikelihood is Dunning's log-likelihood ratio (G2) for x and y occurring together. It is negated if they
+// occur together less often than expected.
+func logLikelihood(xy, x, y, n float64) float64 {
+	sign := 1.0
+	if xy*n < x*y {
+		sign = -1
+	}
+	k11 := xy
+	k12 := x - xy
+	k21 := y - xy
+	k22 := n - x - y + xy
+	return sign * 2 * (xLogX(k11) + xLogX(k12) + xLogX(k21) + xLogX(k22) -
+		xLogX(k11+k12) - xLogX(k21+k22) - xLogX(k11+k21) - xLogX(k12+k22) +
+		xLogX(k11+k12+k21+k22))
+}
+
+func xLogX(v float64) float64 {
+	if v <= 0 {
+		return 0
+	}
+	return v * math.Log(v)
+}
@@ -0,0 +1,75 @@
+package frequency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/models/modelstest"
+)
+
+func phraseStrings(phrases []*api.Phrase) []string {
+	out := []string{}
+	for _, p := range phrases {
+		out = append(out, p.Phrase)
+	}
+	return out
+}
+
+func TestScorePhrases(t *testing.T) {
+	lines := [][]string{}
+	for i := 0; i < 3; i++ {
+		lines = append(lines, Words("it is a little monkey"), Words("of the"), Words("pie and chips"))
+	}
+	// "pie" and "chips" are also said apart so are less strongly associated than "little monkey".
+	lines = append(lines, Words("pie"), Words("chips"), Words("pie"), Words("chips"), Words("it is"))
+
+	tests := []struct {
+		name          string
+		opts          PhraseOptions
+		expectPhrases []string
+	}{
+		{
+			name:          "count excludes stop word only phrases",
+			opts:          PhraseOptions{MinWords: 2, MaxWords: 2, MinCount: 3, MaxResults: 10, Scoring: api.ListPhrasesRequest_COUNT},
+			expectPhrases: []string{"a little", "and chips", "little monkey", "pie and"},
+		},
+		{
+			name:          "min count",
+			opts:          PhraseOptions{MinWords: 2, MaxWords: 5, MinCount: 4, MaxResults: 10, Scoring: api.ListPhrasesRequest_COUNT},
+			expectPhrases: []string{},
+		},
+		{
+			name:          "pmi",
+			opts:          PhraseOptions{MinWords: 2, MaxWords: 3, MinCount: 3, MaxResults: 2, Scoring: api.ListPhrasesRequest_PMI},
+			expectPhrases: []string{"a little", "a little monkey"},
+		},
+		{
+			name:          "log likelihood",
+			opts:          PhraseOptions{MinWords: 3, MaxWords: 5, MinCount: 3, MaxResults: 2, Scoring: api.ListPhrasesRequest_LOG_LIKELIHOOD},
+			expectPhrases: []string{"a little monkey", "is a little"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.EqualValues(t, test.expectPhrases, phraseStrings(scorePhrases(lines, test.opts)))
+		})
+	}
+}
+
+func TestPhrases(t *testing.T) {
+	episodes := testEpisodes{
+		"xfm-S1E01": modelstest.Transcript(
+			1,
+			[2]string{"karl", "little monkey"},
+			[2]string{"ricky", "little monkey"},
+		),
+	}
+	res, err := Phrases(episodes, []string{"xfm-S1E01"}, Scope{Actor: "karl"}, PhraseOptions{MinWords: 2, MaxWords: 2, MinCount: 1, MaxResults: 10})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.EqualValues(t, 1, res[0].Count)
+
+	_, err = Phrases(episodes, []string{"xfm-S1E01"}, Scope{}, PhraseOptions{MinWords: 1, MaxWords: 2, MinCount: 1, MaxResults: 10})
+	require.Error(t, err)
+}
@@ -0,0 +1,135 @@
+package lint
+
+import (
+	"bufio"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/transcript"
+)
+
+type Severity string
+
+const (
+	SeverityInfo    Severity = "info"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+type lineKind int
+
+const (
+	lineBlank lineKind = iota
+	lineDialog
+	lineTag
+	// lineContinuation is an additional line of a synopsis or trivia description.
+	lineContinuation
+)
+
+// Line is a single line of a raw transcript.
+type Line struct {
+	// Num is the 1-indexed line number in the raw transcript.
+	Num  int
+	Text string
+	kind lineKind
+}
+
+// IsDialog is true if the line is dialog rather than a tag.
+func (l Line) IsDialog() bool {
+	return l.kind == lineDialog
+}
+
+// Dialog returns the actor and content of a dialog line. The actor is lowercase and is empty for lines
+// missing an actor.
+func (l Line) Dialog() (string, string) {
+	text := strings.TrimPrefix(l.Text, "!")
+	parts := strings.SplitN(text, ":", 2)
+	if len(parts) != 2 {
+		return "", strings.TrimSpace(text)
+	}
+	return strings.ToLower(strings.TrimSpace(parts[0])), strings.TrimSpace(parts[1])
+}
+
+type Problem struct {
+	Line    int
+	Message string
+}
+
+// Rule checks the lines of a transcript for a single kind of problem.
+type Rule struct {
+	Name     string
+	Severity Severity
+	Check    func(lines []Line) []Problem
+}
+
+type Warning struct {
+	Rule     string
+	Severity Severity
+	Line     int
+	Message  string
+}
+
+func (w Warning) String() string {
+	return fmt.Sprintf("%d: %s: %s (%s)", w.Line, w.Severity, w.Message, w.Rule)
+}
+
+func (w Warning) Proto() *api.TranscriptLintWarning {
+	return &api.TranscriptLintWarning{
+		Rule:     w.Rule,
+		Severity: string(w.Severity),
+		Line:     int32(w.Line),
+		Message:  w.Message,
+	}
+}
+
+type Warnings []Warning
+
+func (w Warnings) Proto() []*api.TranscriptLintWarning {
+	out := make([]*api.TranscriptLintWarning, len(w))
+	for k, v := range w {
+		out[k] = v.Proto()
+	}
+	return out
+}
+
+// Lint runs the rules over the raw transcript. Warnings are ordered by line.
+func Lint(raw string, rules ...Rule) Warnings {
+	lines := parseLines(raw)
+	warnings := Warnings{}
+	for _, rule := range rules {
+		for _, p := range rule.Check(lines) {
+			warnings = append(warnings, Warning{Rule: rule.Name, Severity: rule.Severity, Line: p.Line, Message: p.Message})
+		}
+	}
+	slices.SortStableFunc(warnings, func(a, b Warning) int {
+		return a.Line - b.Line
+	})
+	return warnings
+}
+
+// parseLines splits the transcript into lines in the same way as transcript.Import.
+func parseLines(raw string) []Line {
+	lines := []Line{}
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	inDescription := false
+	for num := 1; scanner.Scan(); num++ {
+		line := Line{Num: num, Text: strings.TrimSpace(strings.ReplaceAll(scanner.Text(), "\u00a0", " "))}
+		switch {
+		case line.Text == "":
+			line.kind = lineBlank
+			inDescription = false
+		case transcript.IsTag(line.Text) || transcript.IsGapTag(line.Text):
+			line.kind = lineTag
+			inDescription = strings.HasPrefix(line.Text, transcript.SynopsisTag.Open()) || strings.HasPrefix(line.Text, transcript.TriviaTag.Open())
+		case inDescription && strings.HasPrefix(line.Text, "#"):
+			line.kind = lineContinuation
+		default:
+			line.kind = lineDialog
+			inDescription = false
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,79 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name       string
+		transcript string
+		want       Warnings
+	}{
+		{
+			name:       "no problems",
+			transcript: "#OFFSET: 1\nricky: Hello\n#OFFSET: 1\nkarl: Alright\n#SYN: Intro\n# more intro\nsteve: Hi\n#/SYN\nsong: La la la\n",
+			want:       Warnings{},
+		},
+		{
+			name:       "range open at end of transcript",
+			transcript: "ricky: Hello\n#TRIVIA: Last line\nkarl: Alright\n",
+			want: Warnings{
+				{Rule: "unclosed-range", Severity: SeverityInfo, Line: 2, Message: "#TRIVIA: is never closed with #/TRIVIA"},
+			},
+		},
+		{
+			name:       "actor typo",
+			transcript: "ricky: Hello\nrikcy: Hello again\n",
+			want: Warnings{
+				{Rule: "actor-typo", Severity: SeverityWarning, Line: 2, Message: `actor "rikcy" is not in the roster, did you mean "ricky"?`},
+			},
+		},
+		{
+			name:       "unknown actors are reported once",
+			transcript: "vicky: Hello\nricky: Hello\nvicky: Hi\nvicky: Hi again\n",
+			want: Warnings{
+				{Rule: "unknown-actor", Severity: SeverityInfo, Line: 1, Message: `actor "vicky" is not in the roster (3 lines)`},
+			},
+		},
+		{
+			name:       "offsets go backwards",
+			transcript: "#OFFSET: 10\nricky: Hello\n#OFFSET: 5\nkarl: Alright\n#OFFSET: foo\nsteve: Hi\n",
+			want: Warnings{
+				{Rule: "offset-order", Severity: SeverityError, Line: 3, Message: "offset 5.00 is before the previous offset 10.00"},
+				{Rule: "offset-order", Severity: SeverityError, Line: 5, Message: "offset is not a number of seconds: #OFFSET: foo"},
+			},
+		},
+		{
+			name:       "tag ranges",
+			transcript: "#SYN: One\nricky: Hello\n#SYN: Two\nkarl: Alright\n#/TRIVIA\nsteve: Hi\n",
+			want: Warnings{
+				{Rule: "tag-range", Severity: SeverityError, Line: 3, Message: "#SYN: opened before the #SYN: on line 1 was closed"},
+				{Rule: "unclosed-range", Severity: SeverityInfo, Line: 3, Message: "#SYN: is never closed with #/SYN"},
+				{Rule: "tag-range", Severity: SeverityError, Line: 5, Message: "#/TRIVIA has no matching #TRIVIA:"},
+			},
+		},
+		{
+			name:       "empty and duplicate lines",
+			transcript: "ricky: Hello\n\nricky: hello\nkarl: \n",
+			want: Warnings{
+				{Rule: "duplicate-line", Severity: SeverityWarning, Line: 3, Message: "line is the same as line 1"},
+				{Rule: "empty-line", Severity: SeverityWarning, Line: 4, Message: "line has no content"},
+			},
+		},
+		{
+			name:       "all caps",
+			transcript: "ricky: WHAT ARE YOU TALKING ABOUT\nkarl: OK\n",
+			want: Warnings{
+				{Rule: "all-caps", Severity: SeverityInfo, Line: 1, Message: "line is all caps"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.EqualValues(t, tt.want, Lint(tt.transcript, DefaultRules()...))
+		})
+	}
+}
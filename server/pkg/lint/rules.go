@@ -0,0 +1,268 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/adrg/strutil/metrics"
+	"github.com/warmans/rsk-search/pkg/meta"
+	"github.com/warmans/rsk-search/pkg/transcript"
+)
+
+const (
+	// minAllCapsLetters stops short acronyms and exclamations being reported as all caps.
+	minAllCapsLetters = 8
+	// maxTypoLines is the most times a misspelled actor is expected to appear in a single transcript.
+	maxTypoLines = 2
+)
+
+// DefaultRules returns all the rules that should be checked for transcripts.
+func DefaultRules() []Rule {
+	return []Rule{
+		{Name: "actor-typo", Severity: SeverityWarning, Check: checkActorTypos},
+		{Name: "unknown-actor", Severity: SeverityInfo, Check: checkUnknownActors},
+		{Name: "offset-order", Severity: SeverityError, Check: checkOffsetOrder},
+		{Name: "tag-range", Severity: SeverityError, Check: checkTagRanges},
+		{Name: "unclosed-range", Severity: SeverityInfo, Check: checkUnclosedRanges},
+		{Name: "empty-line", Severity: SeverityWarning, Check: checkEmptyLines},
+		{Name: "duplicate-line", Severity: SeverityWarning, Check: checkDuplicateLines},
+		{Name: "all-caps", Severity: SeverityInfo, Check: checkAllCaps},
+	}
+}
+
+// checkActorTypos reports actors that are not in the roster but are very similar to an actor that is.
+func checkActorTypos(lines []Line) []Problem {
+	typos := actorTypos(lines)
+	problems := []Problem{}
+	for _, line := range lines {
+		actor, ok := unknownActor(line)
+		if !ok {
+			continue
+		}
+		if suggestion, ok := typos[actor]; ok {
+			problems = append(problems, Problem{Line: line.Num, Message: fmt.Sprintf("actor %q is not in the roster, did you mean %q?", actor, suggestion)})
+		}
+	}
+	return problems
+}
+
+// checkUnknownActors reports the first line of each actor that is not in the roster. Guests are expected
+// to be reported so these are only informational.
+func checkUnknownActors(lines []Line) []Problem {
+	typos := actorTypos(lines)
+	first := map[string]int{}
+	counts := map[string]int{}
+	order := []string{}
+	for _, line := range lines {
+		actor, ok := unknownActor(line)
+		if !ok {
+			continue
+		}
+		if _, typo := typos[actor]; typo {
+			continue
+		}
+		if _, seen := first[actor]; !seen {
+			first[actor] = line.Num
+			order = append(order, actor)
+		}
+		counts[actor]++
+	}
+	problems := []Problem{}
+	for _, actor := range order {
+		problems = append(problems, Problem{Line: first[actor], Message: fmt.Sprintf("actor %q is not in the roster (%d lines)", actor, counts[actor])})
+	}
+	return problems
+}
+
+func unknownActor(line Line) (string, bool) {
+	if !line.IsDialog() {
+		return "", false
+	}
+	actor, _ := line.Dialog()
+	if actor == "" || actor == "none" || actor == "song" || meta.IsKnownActor(actor) {
+		return "", false
+	}
+	return actor, true
+}
+
+// actorTypos maps unknown actors that are probably misspellings to the actor they were meant to be. Actors
+// with many lines are assumed to be guests with a similar name rather than a typo.
+func actorTypos(lines []Line) map[string]string {
+	counts := map[string]int{}
+	for _, line := range lines {
+		if actor, ok := unknownActor(line); ok {
+			counts[actor]++
+		}
+	}
+	typos := map[string]string{}
+	for actor, count := range counts {
+		if count > maxTypoLines {
+			continue
+		}
+		if suggestion := closestActor(actor); suggestion != "" {
+			typos[actor] = suggestion
+		}
+	}
+	return typos
+}
+
+// closestActor returns the roster actor the given actor is most likely a misspelling of, or an empty string.
+func closestActor(actor string) string {
+	maxDistance := 0
+	switch length := len([]rune(actor)); {
+	case length >= 5:
+		maxDistance = 2
+	case length >= 4:
+		maxDistance = 1
+	}
+	lev := metrics.NewLevenshtein()
+	closest := ""
+	closestDistance := maxDistance + 1
+	for _, known := range meta.ActorRoster() {
+		if distance := lev.Distance(actor, known); distance < closestDistance {
+			closest = known
+			closestDistance = distance
+		}
+	}
+	return closest
+}
+
+// checkOffsetOrder reports offsets that are invalid or go backwards. Lines may share an offset.
+func checkOffsetOrder(lines []Line) []Problem {
+	problems := []Problem{}
+	var lastOffset time.Duration
+	for _, line := range lines {
+		if line.kind != lineTag || !transcript.IsOffsetTag(line.Text) {
+			continue
+		}
+		offset, ok := transcript.ScanSeconds(transcript.OffsetTag, line.Text)
+		if !ok {
+			problems = append(problems, Problem{Line: line.Num, Message: fmt.Sprintf("offset is not a number of seconds: %s", line.Text)})
+			continue
+		}
+		if offset < lastOffset {
+			problems = append(problems, Problem{Line: line.Num, Message: fmt.Sprintf("offset %0.2f is before the previous offset %0.2f", offset.Seconds(), lastOffset.Seconds())})
+			continue
+		}
+		lastOffset = offset
+	}
+	return problems
+}
+
+// rangeTags are the open and close tags of each kind of range.
+var rangeTags = [][2]string{
+	{transcript.SynopsisTag.Open(), transcript.SynopsisTag.Close()},
+	{transcript.TriviaTag.Open(), transcript.TriviaTag.Close()},
+}
+
+// checkTagRanges reports synopsis and trivia ranges that overlap or are closed without being opened.
+func checkTagRanges(lines []Line) []Problem {
+	problems := []Problem{}
+	for _, tags := range rangeTags {
+		openTag, closeTag := tags[0], tags[1]
+		openedAt := 0
+		for _, line := range lines {
+			if line.kind != lineTag {
+				continue
+			}
+			switch {
+			case strings.HasPrefix(line.Text, openTag):
+				if openedAt > 0 {
+					problems = append(problems, Problem{Line: line.Num, Message: fmt.Sprintf("%s opened before the %s on line %d was closed", openTag, openTag, openedAt)})
+				}
+				openedAt = line.Num
+			case strings.HasPrefix(line.Text, closeTag):
+				if openedAt == 0 {
+					problems = append(problems, Problem{Line: line.Num, Message: fmt.Sprintf("%s has no matching %s", closeTag, openTag)})
+				}
+				openedAt = 0
+			}
+		}
+	}
+	return problems
+}
+
+// checkUnclosedRanges reports synopsis and trivia ranges left open at the end of the transcript. These are valid
+// as the range continues to the last line but the close tag may have been forgotten.
+func checkUnclosedRanges(lines []Line) []Problem {
+	problems := []Problem{}
+	for _, tags := range rangeTags {
+		openTag, closeTag := tags[0], tags[1]
+		openedAt := 0
+		for _, line := range lines {
+			if line.kind != lineTag {
+				continue
+			}
+			switch {
+			case strings.HasPrefix(line.Text, openTag):
+				openedAt = line.Num
+			case strings.HasPrefix(line.Text, closeTag):
+				openedAt = 0
+			}
+		}
+		if openedAt > 0 {
+			problems = append(problems, Problem{Line: openedAt, Message: fmt.Sprintf("%s is never closed with %s", openTag, closeTag)})
+		}
+	}
+	return problems
+}
+
+// checkEmptyLines reports dialog with no content.
+func checkEmptyLines(lines []Line) []Problem {
+	problems := []Problem{}
+	for _, line := range lines {
+		if !line.IsDialog() {
+			continue
+		}
+		if _, content := line.Dialog(); content == "" {
+			problems = append(problems, Problem{Line: line.Num, Message: "line has no content"})
+		}
+	}
+	return problems
+}
+
+// checkDuplicateLines reports dialog that is the same as the dialog before it. Tags between the lines are
+// ignored.
+func checkDuplicateLines(lines []Line) []Problem {
+	problems := []Problem{}
+	prevNum, prevActor, prevContent := 0, "", ""
+	for _, line := range lines {
+		if !line.IsDialog() {
+			continue
+		}
+		actor, content := line.Dialog()
+		if prevNum > 0 && content != "" && actor == prevActor && strings.EqualFold(content, prevContent) {
+			problems = append(problems, Problem{Line: line.Num, Message: fmt.Sprintf("line is the same as line %d", prevNum)})
+		}
+		prevNum, prevActor, prevContent = line.Num, actor, content
+	}
+	return problems
+}
+
+// checkAllCaps reports dialog written entirely in capitals, which is usually pasted from somewhere else.
+func checkAllCaps(lines []Line) []Problem {
+	problems := []Problem{}
+	for _, line := range lines {
+		if !line.IsDialog() {
+			continue
+		}
+		_, content := line.Dialog()
+		letters := 0
+		lower := false
+		for _, r := range content {
+			if unicode.IsLetter(r) {
+				letters++
+			}
+			if unicode.IsLower(r) {
+				lower = true
+				break
+			}
+		}
+		if !lower && letters >= minAllCapsLetters {
+			problems = append(problems, Problem{Line: line.Num, Message: "line is all caps"})
+		}
+	}
+	return problems
+}
@@ -0,0 +1,48 @@
+package meta
+
+import (
+	"embed"
+	"encoding/json"
+	"io/fs"
+	"slices"
+	"strings"
+)
+
+//go:embed data/actors.json
+var actors embed.FS
+
+// actorRoster is the list of regular actors. Guests and clips are not included.
+var actorRoster = []string{}
+
+func init() {
+	f, err := actors.Open("data/actors.json")
+	if err != nil {
+		panic("failed to open embedded metadata: " + err.Error())
+	}
+	defer func(f fs.File) {
+		_ = f.Close()
+	}(f)
+
+	if err := json.NewDecoder(f).Decode(&actorRoster); err != nil {
+		panic("failed to decode metadata: " + err.Error())
+	}
+}
+
+// ActorRoster returns the regular actors.
+func ActorRoster() []string {
+	return slices.Clone(actorRoster)
+}
+
+// IsKnownActor checks if the actor is in the roster, or is an alias of an actor in the roster.
+func IsKnownActor(actor string) bool {
+	actor = strings.ToLower(strings.TrimSpace(actor))
+	if slices.Contains(actorRoster, actor) {
+		return true
+	}
+	for _, alias := range Aliases("actor", actor) {
+		if slices.Contains(actorRoster, strings.ToLower(alias)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package meta
+
+import (
+	"embed"
+	"encoding/json"
+	"io/fs"
+	"slices"
+	"strings"
+)
+
+//go:embed data/aliases.json
+var aliases embed.FS
+
+// aliasData is the curated alias dictionary. Groups contain interchangeable forms of the same word such as
+// spelling variants, short forms and regional forms. Any term in a group is expanded to the others. Nicknames
+// are other ways of referring to a name e.g. a surname. They only expand one way: searching for "karl" also
+// finds "pilkington" but searching for "pilkington" does not match every line that mentions karl.
+type aliasData struct {
+	Groups    map[string][][]string          `json:"groups"`
+	Nicknames map[string]map[string][]string `json:"nicknames"`
+}
+
+var aliasDict = aliasData{}
+
+// aliasIndex maps each lowercase term to the index of its group, per field.
+var aliasIndex = map[string]map[string]int{}
+
+func init() {
+	f, err := aliases.Open("data/aliases.json")
+	if err != nil {
+		panic("failed to open embedded metadata: " + err.Error())
+	}
+	defer func(f fs.File) {
+		_ = f.Close()
+	}(f)
+
+	if err := json.NewDecoder(f).Decode(&aliasDict); err != nil {
+		panic("failed to decode metadata: " + err.Error())
+	}
+	for field, groups := range aliasDict.Groups {
+		aliasIndex[field] = map[string]int{}
+		for k, group := range groups {
+			for _, term := range group {
+				aliasIndex[field][strings.ToLower(term)] = k
+			}
+		}
+	}
+}
+
+// Aliases returns the other terms with the same meaning as the given term in the field. Terms are matched
+// case-insensitively.
+func Aliases(field string, term string) []string {
+	k, ok := aliasIndex[field][strings.ToLower(term)]
+	if !ok {
+		return nil
+	}
+	out := []string{}
+	for _, alias := range aliasDict.Groups[field][k] {
+		if !strings.EqualFold(alias, term) {
+			out = append(out, alias)
+		}
+	}
+	return out
+}
+
+// Nicknames returns the nicknames of the name in the field. The name may be given as any of its aliases.
+func Nicknames(field string, name string) []string {
+	for _, n := range append([]string{name}, Aliases(field, name)...) {
+		if nicknames, ok := aliasDict.Nicknames[field][strings.ToLower(n)]; ok {
+			return slices.Clone(nicknames)
+		}
+	}
+	return nil
+}
@@ -83,6 +83,9 @@ func GetSearchMeta() SearchMeta {
 			{Name: "content", Kind: FieldText},
 			{Name: "type", Kind: FieldKeyword},
 			{Name: "tags", Kind: FieldKeywordList},
+			{Name: "doc_type", Kind: FieldKeyword},
+			{Name: "offset", Kind: FieldFloat},
+			{Name: "offset_inferred", Kind: FieldInt},
 		},
 	}
 }
@@ -14,6 +14,8 @@ type TranscriptChangeCreate struct {
 	Summary           string
 	ReleaseDate       time.Time
 	Transcription     string
+	// BaseTranscription is the transcript the change was based on.
+	BaseTranscription string
 }
 
 type TranscriptChangeUpdate struct {
@@ -34,6 +36,7 @@ type TranscriptChange struct {
 	Summary           string
 	ReleaseDate       time.Time
 	Transcription     string
+	BaseTranscription string
 	State             ContributionState
 	CreatedAt         time.Time
 	Merged            bool
@@ -0,0 +1,19 @@
+// Package modelstest provides fixtures for tests that need transcripts.
+package modelstest
+
+import "github.com/warmans/rsk-search/pkg/models"
+
+// Transcript creates an xfm series 1 episode with a line of chat for each actor/content pair.
+func Transcript(episode int32, lines ...[2]string) *models.Transcript {
+	ep := &models.Transcript{Publication: "xfm", Series: 1, Episode: episode}
+	for k, v := range lines {
+		ep.Transcript = append(ep.Transcript, models.Dialog{
+			ID:       models.DialogID(ep.ID(), int64(k+1)),
+			Position: int64(k + 1),
+			Type:     models.DialogTypeChat,
+			Actor:    v[0],
+			Content:  v[1],
+		})
+	}
+	return ep
+}
@@ -0,0 +1,54 @@
+package phonetic
+
+import (
+	"strings"
+
+	"github.com/blugelabs/bluge/analysis"
+	"github.com/blugelabs/bluge/analysis/token"
+	"github.com/blugelabs/bluge/analysis/tokenizer"
+)
+
+// Analyzer splits text into words the same way as the default text analyzer then replaces each word with its
+// phonetic codes. The original offsets are kept so matches can be highlighted in the original text.
+var Analyzer = &analysis.Analyzer{
+	Tokenizer: tokenizer.NewUnicodeTokenizer(),
+	TokenFilters: []analysis.TokenFilter{
+		token.NewLowerCaseFilter(),
+		&Filter{},
+	},
+}
+
+// Filter replaces each token with its phonetic codes. If a word has an alternate code it is added at the same
+// position.
+type Filter struct{}
+
+func (f *Filter) Filter(input analysis.TokenStream) analysis.TokenStream {
+	out := make(analysis.TokenStream, 0, len(input))
+	for _, tok := range input {
+		for k, code := range Codes(string(tok.Term)) {
+			coded := *tok
+			coded.Term = []byte(code)
+			if k > 0 {
+				coded.PositionIncr = 0
+			}
+			out = append(out, &coded)
+		}
+	}
+	return out
+}
+
+// Codes returns the distinct phonetic codes for the word. Words with no code e.g. numbers are returned as
+// they are so they can still be matched.
+func Codes(word string) []string {
+	primary, alternate := DoubleMetaphone(word)
+	if primary == "" && alternate == "" {
+		return []string{strings.ToLower(word)}
+	}
+	if alternate == "" || alternate == primary {
+		return []string{primary}
+	}
+	if primary == "" {
+		return []string{alternate}
+	}
+	return []string{primary, alternate}
+}
@@ -0,0 +1,577 @@
+package phonetic
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// maxCodeLength is the length codes are truncated to. Longer codes distinguish words that sound quite different
+// at the end but make it less likely misspellings will match.
+const maxCodeLength = 4
+
+// DoubleMetaphone returns the primary and alternate phonetic codes for the word using Lawrence Philips' Double
+// Metaphone algorithm. The alternate code is the same as the primary if the word has only one likely
+// pronunciation. Words with no letters return empty codes.
+func DoubleMetaphone(word string) (string, string) {
+	e := newEncoder(word)
+	if e.length == 0 {
+		return "", ""
+	}
+	e.encode()
+	return truncate(e.primary.String()), truncate(e.alternate.String())
+}
+
+func truncate(code string) string {
+	if len(code) > maxCodeLength {
+		return code[:maxCodeLength]
+	}
+	return code
+}
+
+type encoder struct {
+	// word is uppercase and padded with spaces so that the ends of words can be matched as " ".
+	word          []rune
+	length        int
+	last          int
+	slavoGermanic bool
+	primary       strings.Builder
+	alternate     strings.Builder
+}
+
+func newEncoder(word string) *encoder {
+	letters := []rune{}
+	for _, r := range strings.ToUpper(word) {
+		if unicode.IsLetter(r) {
+			letters = append(letters, r)
+		}
+	}
+	upper := string(letters)
+	return &encoder{
+		word:   []rune(upper + "     "),
+		length: len(letters),
+		last:   len(letters) - 1,
+		slavoGermanic: strings.Contains(upper, "W") ||
+			strings.Contains(upper, "K") ||
+			strings.Contains(upper, "CZ") ||
+			strings.Contains(upper, "WITZ"),
+	}
+}
+
+func (e *encoder) at(pos int) rune {
+	if pos < 0 || pos >= len(e.word) {
+		return 0
+	}
+	return e.word[pos]
+}
+
+// stringAt checks if any of the options appear at the given position.
+func (e *encoder) stringAt(pos int, length int, options ...string) bool {
+	if pos < 0 || pos+length > len(e.word) {
+		return false
+	}
+	return slices.Contains(options, string(e.word[pos:pos+length]))
+}
+
+func (e *encoder) isVowel(pos int) bool {
+	return strings.ContainsRune("AEIOUY", e.at(pos))
+}
+
+func (e *encoder) add(main string) {
+	e.primary.WriteString(main)
+	e.alternate.WriteString(main)
+}
+
+func (e *encoder) addAlt(main string, alt string) {
+	e.primary.WriteString(main)
+	e.alternate.WriteString(alt)
+}
+
+// skip returns the number of letters to advance if the next letter is a repeat of the current one.
+func (e *encoder) skip(current int, r rune) int {
+	if e.at(current+1) == r {
+		return 2
+	}
+	return 1
+}
+
+func (e *encoder) encode() {
+	current := 0
+
+	// skip these when at start of word
+	if e.stringAt(0, 2, "GN", "KN", "PN", "WR", "PS") {
+		current++
+	}
+	// initial 'X' is pronounced 'Z' e.g. 'xavier'
+	if e.at(0) == 'X' {
+		e.add("S")
+		current++
+	}
+
+	for (e.primary.Len() < maxCodeLength || e.alternate.Len() < maxCodeLength) && current < e.length {
+		switch e.at(current) {
+		case 'A', 'E', 'I', 'O', 'U', 'Y':
+			// all initial vowels map to 'A'
+			if current == 0 {
+				e.add("A")
+			}
+			current++
+		case 'B':
+			e.add("P")
+			current += e.skip(current, 'B')
+		case 'Ç':
+			e.add("S")
+			current++
+		case 'C':
+			current = e.encodeC(current)
+		case 'D':
+			if e.stringAt(current, 2, "DG") {
+				if e.stringAt(current+2, 1, "I", "E", "Y") {
+					// e.g. 'edge'
+					e.add("J")
+					current += 3
+				} else {
+					// e.g. 'edgar'
+					e.add("TK")
+					current += 2
+				}
+				continue
+			}
+			e.add("T")
+			if e.stringAt(current, 2, "DT", "DD") {
+				current += 2
+			} else {
+				current++
+			}
+		case 'F':
+			e.add("F")
+			current += e.skip(current, 'F')
+		case 'G':
+			current = e.encodeG(current)
+		case 'H':
+			// only keep if first & before vowel or between 2 vowels
+			if (current == 0 || e.isVowel(current-1)) && e.isVowel(current+1) {
+				e.add("H")
+				current += 2
+			} else {
+				current++
+			}
+		case 'J':
+			current = e.encodeJ(current)
+		case 'K':
+			e.add("K")
+			current += e.skip(current, 'K')
+		case 'L':
+			if e.at(current+1) == 'L' {
+				// spanish e.g. 'cabrillo', 'gallegos'
+				if (current == e.length-3 && e.stringAt(current-1, 4, "ILLO", "ILLA", "ALLE")) ||
+					((e.stringAt(e.last-1, 2, "AS", "OS") || e.stringAt(e.last, 1, "A", "O")) && e.stringAt(current-1, 4, "ALLE")) {
+					e.addAlt("L", "")
+					current += 2
+					continue
+				}
+				current += 2
+			} else {
+				current++
+			}
+			e.add("L")
+		case 'M':
+			// e.g. 'dumb', 'thumb'
+			if (e.stringAt(current-1, 3, "UMB") && (current+1 == e.last || e.stringAt(current+2, 2, "ER"))) || e.at(current+1) == 'M' {
+				current += 2
+			} else {
+				current++
+			}
+			e.add("M")
+		case 'N':
+			e.add("N")
+			current += e.skip(current, 'N')
+		case 'Ñ':
+			e.add("N")
+			current++
+		case 'P':
+			if e.at(current+1) == 'H' {
+				e.add("F")
+				current += 2
+				continue
+			}
+			// also account for "campbell", "raspberry"
+			if e.stringAt(current+1, 1, "P", "B") {
+				current += 2
+			} else {
+				current++
+			}
+			e.add("P")
+		case 'Q':
+			e.add("K")
+			current += e.skip(current, 'Q')
+		case 'R':
+			// french e.g. 'rogier', but exclude 'hochmeier'
+			if current == e.last && !e.slavoGermanic && e.stringAt(current-2, 2, "IE") && !e.stringAt(current-4, 2, "ME", "MA") {
+				e.addAlt("", "R")
+			} else {
+				e.add("R")
+			}
+			current += e.skip(current, 'R')
+		case 'S':
+			current = e.encodeS(current)
+		case 'T':
+			if e.stringAt(current, 4, "TION") || e.stringAt(current, 3, "TIA", "TCH") {
+				e.add("X")
+				current += 3
+				continue
+			}
+			if e.stringAt(current, 2, "TH") || e.stringAt(current, 3, "TTH") {
+				// special case 'thomas', 'thames' or germanic
+				if e.stringAt(current+2, 2, "OM", "AM") || e.stringAt(0, 4, "VAN ", "VON ") || e.stringAt(0, 3, "SCH") {
+					e.add("T")
+				} else {
+					e.addAlt("0", "T")
+				}
+				current += 2
+				continue
+			}
+			if e.stringAt(current+1, 1, "T", "D") {
+				current += 2
+			} else {
+				current++
+			}
+			e.add("T")
+		case 'V':
+			e.add("F")
+			current += e.skip(current, 'V')
+		case 'W':
+			current = e.encodeW(current)
+		case 'X':
+			// french e.g. 'breaux'
+			if !(current == e.last && (e.stringAt(current-3, 3, "IAU", "EAU") || e.stringAt(current-2, 2, "AU", "OU"))) {
+				e.add("KS")
+			}
+			if e.stringAt(current+1, 1, "C", "X") {
+				current += 2
+			} else {
+				current++
+			}
+		case 'Z':
+			// chinese pinyin e.g. 'zhao'
+			if e.at(current+1) == 'H' {
+				e.add("J")
+				current += 2
+				continue
+			}
+			if e.stringAt(current+1, 2, "ZO", "ZI", "ZA") || (e.slavoGermanic && current > 0 && e.at(current-1) != 'T') {
+				e.addAlt("S", "TS")
+			} else {
+				e.add("S")
+			}
+			current += e.skip(current, 'Z')
+		default:
+			current++
+		}
+	}
+}
+
+func (e *encoder) encodeC(current int) int {
+	// various germanic
+	if current > 1 && !e.isVowel(current-2) && e.stringAt(current-1, 3, "ACH") &&
+		e.at(current+2) != 'I' && (e.at(current+2) != 'E' || e.stringAt(current-2, 6, "BACHER", "MACHER")) {
+		e.add("K")
+		return current + 2
+	}
+	// special case 'caesar'
+	if current == 0 && e.stringAt(current, 6, "CAESAR") {
+		e.add("S")
+		return current + 2
+	}
+	// italian 'chianti'
+	if e.stringAt(current, 4, "CHIA") {
+		e.add("K")
+		return current + 2
+	}
+	if e.stringAt(current, 2, "CH") {
+		// e.g. 'michael'
+		if current > 0 && e.stringAt(current, 4, "CHAE") {
+			e.addAlt("K", "X")
+			return current + 2
+		}
+		// greek roots e.g. 'chemistry', 'chorus'
+		if current == 0 && (e.stringAt(current+1, 5, "HARAC", "HARIS") || e.stringAt(current+1, 3, "HOR", "HYM", "HIA", "HEM")) && !e.stringAt(0, 5, "CHORE") {
+			e.add("K")
+			return current + 2
+		}
+		// germanic, greek, or otherwise 'ch' for 'kh' sound
+		if e.stringAt(0, 4, "VAN ", "VON ") || e.stringAt(0, 3, "SCH") ||
+			// 'architect but not 'arch', 'orchestra', 'orchid'
+			e.stringAt(current-2, 6, "ORCHES", "ARCHIT", "ORCHID") ||
+			e.stringAt(current+2, 1, "T", "S") ||
+			((e.stringAt(current-1, 1, "A", "O", "U", "E") || current == 0) &&
+				// e.g. 'wachtler', 'wechsler', but not 'tichner'
+				e.stringAt(current+2, 1, "L", "R", "N", "M", "B", "H", "F", "V", "W", " ")) {
+			e.add("K")
+		} else if current > 0 {
+			if e.stringAt(0, 2, "MC") {
+				// e.g. 'mchugh'
+				e.add("K")
+			} else {
+				e.addAlt("X", "K")
+			}
+		} else {
+			e.add("X")
+		}
+		return current + 2
+	}
+	// e.g. 'czerny'
+	if e.stringAt(current, 2, "CZ") && !e.stringAt(current-2, 4, "WICZ") {
+		e.addAlt("S", "X")
+		return current + 2
+	}
+	// e.g. 'focaccia'
+	if e.stringAt(current+1, 3, "CIA") {
+		e.add("X")
+		return current + 3
+	}
+	// double 'C', but not if e.g. 'McClellan'
+	if e.stringAt(current, 2, "CC") && !(current == 1 && e.at(0) == 'M') {
+		// 'bellocchio' but not 'bacchus'
+		if e.stringAt(current+2, 1, "I", "E", "H") && !e.stringAt(current+2, 2, "HU") {
+			if (current == 1 && e.at(current-1) == 'A') || e.stringAt(current-1, 5, "UCCEE", "UCCES") {
+				// 'accident', 'accede', 'succeed'
+				e.add("KS")
+			} else {
+				// 'bacci', 'bertucci', other italian
+				e.add("X")
+			}
+			return current + 3
+		}
+		// Pierce's rule
+		e.add("K")
+		return current + 2
+	}
+	if e.stringAt(current, 2, "CK", "CG", "CQ") {
+		e.add("K")
+		return current + 2
+	}
+	if e.stringAt(current, 2, "CI", "CE", "CY") {
+		// italian vs. english
+		if e.stringAt(current, 3, "CIO", "CIE", "CIA") {
+			e.addAlt("S", "X")
+		} else {
+			e.add("S")
+		}
+		return current + 2
+	}
+	e.add("K")
+	// names e.g. 'mac caffrey', 'mac gregor'
+	if e.stringAt(current+1, 2, " C", " Q", " G") {
+		return current + 3
+	}
+	if e.stringAt(current+1, 1, "C", "K", "Q") && !e.stringAt(current+1, 2, "CE", "CI") {
+		return current + 2
+	}
+	return current + 1
+}
+
+func (e *encoder) encodeG(current int) int {
+	if e.at(current+1) == 'H' {
+		if current > 0 && !e.isVowel(current-1) {
+			e.add("K")
+			return current + 2
+		}
+		// 'ghislane', 'ghiradelli'
+		if current == 0 {
+			if e.at(current+2) == 'I' {
+				e.add("J")
+			} else {
+				e.add("K")
+			}
+			return current + 2
+		}
+		// Parker's rule (with some further refinements) e.g. 'hugh', 'bough', 'broughton'
+		if (current > 1 && e.stringAt(current-2, 1, "B", "H", "D")) ||
+			(current > 2 && e.stringAt(current-3, 1, "B", "H", "D")) ||
+			(current > 3 && e.stringAt(current-4, 1, "B", "H")) {
+			return current + 2
+		}
+		// e.g. 'laugh', 'mclaughlin', 'cough', 'gough', 'rough', 'tough'
+		if current > 2 && e.at(current-1) == 'U' && e.stringAt(current-3, 1, "C", "G", "L", "R", "T") {
+			e.add("F")
+		} else if current > 0 && e.at(current-1) != 'I' {
+			e.add("K")
+		}
+		return current + 2
+	}
+	if e.at(current+1) == 'N' {
+		if current == 1 && e.isVowel(0) && !e.slavoGermanic {
+			e.addAlt("KN", "N")
+		} else if !e.stringAt(current+2, 2, "EY") && e.at(current+1) != 'Y' && !e.slavoGermanic {
+			// not e.g. 'cagney'
+			e.addAlt("N", "KN")
+		} else {
+			e.add("KN")
+		}
+		return current + 2
+	}
+	// 'tagliaro'
+	if e.stringAt(current+1, 2, "LI") && !e.slavoGermanic {
+		e.addAlt("KL", "L")
+		return current + 2
+	}
+	// -ges-, -gep-, -gel-, -gie- at beginning
+	if current == 0 && (e.at(current+1) == 'Y' || e.stringAt(current+1, 2, "ES", "EP", "EB", "EL", "EY", "IB", "IL", "IN", "IE", "EI", "ER")) {
+		e.addAlt("K", "J")
+		return current + 2
+	}
+	// -ger-, -gy-
+	if (e.stringAt(current+1, 2, "ER") || e.at(current+1) == 'Y') &&
+		!e.stringAt(0, 6, "DANGER", "RANGER", "MANGER") &&
+		!e.stringAt(current-1, 1, "E", "I") &&
+		!e.stringAt(current-1, 3, "RGY", "OGY") {
+		e.addAlt("K", "J")
+		return current + 2
+	}
+	// italian e.g. 'biaggi'
+	if e.stringAt(current+1, 1, "E", "I", "Y") || e.stringAt(current-1, 4, "AGGI", "OGGI") {
+		if e.stringAt(0, 4, "VAN ", "VON ") || e.stringAt(0, 3, "SCH") || e.stringAt(current+1, 2, "ET") {
+			// obvious germanic
+			e.add("K")
+		} else if e.stringAt(current+1, 4, "IER ") {
+			// always soft if french ending
+			e.add("J")
+		} else {
+			e.addAlt("J", "K")
+		}
+		return current + 2
+	}
+	e.add("K")
+	return current + e.skip(current, 'G')
+}
+
+func (e *encoder) encodeJ(current int) int {
+	// obvious spanish e.g. 'jose', 'san jacinto'
+	if e.stringAt(current, 4, "JOSE") || e.stringAt(0, 4, "SAN ") {
+		if (current == 0 && e.at(current+4) == ' ') || e.stringAt(0, 4, "SAN ") {
+			e.add("H")
+		} else {
+			e.addAlt("J", "H")
+		}
+		return current + 1
+	}
+	if current == 0 {
+		// e.g. 'yankelovich', 'jankelowicz'
+		e.addAlt("J", "A")
+	} else if e.isVowel(current-1) && !e.slavoGermanic && (e.at(current+1) == 'A' || e.at(current+1) == 'O') {
+		// spanish pronunciation of e.g. 'bajador'
+		e.addAlt("J", "H")
+	} else if current == e.last {
+		e.addAlt("J", "")
+	} else if !e.stringAt(current+1, 1, "L", "T", "K", "S", "N", "M", "B", "Z") && !e.stringAt(current-1, 1, "S", "K", "L") {
+		e.add("J")
+	}
+	return current + e.skip(current, 'J')
+}
+
+func (e *encoder) encodeS(current int) int {
+	// special cases 'island', 'isle', 'carlisle', 'carlysle'
+	if e.stringAt(current-1, 3, "ISL", "YSL") {
+		return current + 1
+	}
+	// special case 'sugar-'
+	if current == 0 && e.stringAt(current, 5, "SUGAR") {
+		e.addAlt("X", "S")
+		return current + 1
+	}
+	if e.stringAt(current, 2, "SH") {
+		// germanic
+		if e.stringAt(current+1, 4, "HEIM", "HOEK", "HOLM", "HOLZ") {
+			e.add("S")
+		} else {
+			e.add("X")
+		}
+		return current + 2
+	}
+	// italian & armenian
+	if e.stringAt(current, 3, "SIO", "SIA") || e.stringAt(current, 4, "SIAN") {
+		if !e.slavoGermanic {
+			e.addAlt("S", "X")
+		} else {
+			e.add("S")
+		}
+		return current + 3
+	}
+	// german & anglicisations e.g. 'smith' matches 'schmidt', 'snider' matches 'schneider'. Also -sz- in
+	// slavic languages although in hungarian it is pronounced 's'.
+	if (current == 0 && e.stringAt(current+1, 1, "M", "N", "L", "W")) || e.stringAt(current+1, 1, "Z") {
+		e.addAlt("S", "X")
+		if e.stringAt(current+1, 1, "Z") {
+			return current + 2
+		}
+		return current + 1
+	}
+	if e.stringAt(current, 2, "SC") {
+		// Schlesinger's rule
+		if e.at(current+2) == 'H' {
+			// dutch origin e.g. 'school', 'schooner'
+			if e.stringAt(current+3, 2, "OO", "ER", "EN", "UY", "ED", "EM") {
+				// 'schermerhorn', 'schenker'
+				if e.stringAt(current+3, 2, "ER", "EN") {
+					e.addAlt("X", "SK")
+				} else {
+					e.add("SK")
+				}
+				return current + 3
+			}
+			if current == 0 && !e.isVowel(3) && e.at(3) != 'W' {
+				e.addAlt("X", "S")
+			} else {
+				e.add("X")
+			}
+			return current + 3
+		}
+		if e.stringAt(current+2, 1, "I", "E", "Y") {
+			e.add("S")
+			return current + 3
+		}
+		e.add("SK")
+		return current + 3
+	}
+	// french e.g. 'resnais', 'artois'
+	if current == e.last && e.stringAt(current-2, 2, "AI", "OI") {
+		e.addAlt("", "S")
+	} else {
+		e.add("S")
+	}
+	if e.stringAt(current+1, 1, "S", "Z") {
+		return current + 2
+	}
+	return current + 1
+}
+
+func (e *encoder) encodeW(current int) int {
+	// can also be in the middle of a word
+	if e.stringAt(current, 2, "WR") {
+		e.add("R")
+		return current + 2
+	}
+	if current == 0 && (e.isVowel(current+1) || e.stringAt(current, 2, "WH")) {
+		if e.isVowel(current + 1) {
+			// 'wasserman' should match 'vasserman'
+			e.addAlt("A", "F")
+		} else {
+			// need 'uomo' to match 'womo'
+			e.add("A")
+		}
+	}
+	// 'arnow' should match 'arnoff'
+	if (current == e.last && e.isVowel(current-1)) ||
+		e.stringAt(current-1, 5, "EWSKI", "EWSKY", "OWSKI", "OWSKY") ||
+		e.stringAt(0, 3, "SCH") {
+		e.addAlt("", "F")
+		return current + 1
+	}
+	// polish e.g. 'filipowicz'
+	if e.stringAt(current, 4, "WICZ", "WITZ") {
+		e.addAlt("TS", "FX")
+		return current + 4
+	}
+	return current + 1
+}
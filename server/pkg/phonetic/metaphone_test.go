@@ -0,0 +1,55 @@
+package phonetic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoubleMetaphone(t *testing.T) {
+	tests := []struct {
+		word      string
+		primary   string
+		alternate string
+	}{
+		{word: "", primary: "", alternate: ""},
+		{word: "123", primary: "", alternate: ""},
+		{word: "hoberman", primary: "HPRM", alternate: "HPRM"},
+		{word: "Hobbermann", primary: "HPRM", alternate: "HPRM"},
+		{word: "pilkington", primary: "PLKN", alternate: "PLKN"},
+		{word: "karl", primary: "KRL", alternate: "KRL"},
+		{word: "carl", primary: "KRL", alternate: "KRL"},
+		{word: "smith", primary: "SM0", alternate: "XMT"},
+		{word: "schmidt", primary: "XMT", alternate: "SMT"},
+		{word: "thomas", primary: "TMS", alternate: "TMS"},
+		{word: "knight", primary: "NT", alternate: "NT"},
+		{word: "laugh", primary: "LF", alternate: "LF"},
+		{word: "michael", primary: "MKL", alternate: "MXL"},
+		{word: "caesar", primary: "SSR", alternate: "SSR"},
+		{word: "xavier", primary: "SF", alternate: "SFR"},
+		{word: "edge", primary: "AJ", alternate: "AJ"},
+		{word: "jose", primary: "HS", alternate: "HS"},
+		{word: "gervais", primary: "KRF", alternate: "JRFS"},
+		{word: "manchester", primary: "MNXS", alternate: "MNKS"},
+	}
+	for _, test := range tests {
+		t.Run(test.word, func(t *testing.T) {
+			primary, alternate := DoubleMetaphone(test.word)
+			require.Equal(t, test.primary, primary)
+			require.Equal(t, test.alternate, alternate)
+		})
+	}
+}
+
+func TestAnalyzer(t *testing.T) {
+	tokens := Analyzer.Analyze([]byte("Mr Smith, 1984"))
+	require.Len(t, tokens, 4)
+	require.Equal(t, "MR", string(tokens[0].Term))
+	require.Equal(t, "SM0", string(tokens[1].Term))
+	require.Equal(t, 1, tokens[1].PositionIncr)
+	// the alternate code is at the same position and has the offsets of the original word.
+	require.Equal(t, "XMT", string(tokens[2].Term))
+	require.Equal(t, 0, tokens[2].PositionIncr)
+	require.Equal(t, "Smith", "Mr Smith, 1984"[tokens[2].Start:tokens[2].End])
+	require.Equal(t, "1984", string(tokens[3].Term))
+}
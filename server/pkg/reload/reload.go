@@ -0,0 +1,125 @@
+package reload
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"github.com/warmans/rsk-search/pkg/flag"
+	"go.uber.org/zap"
+)
+
+type Config struct {
+	GenerationFile string
+	CheckInterval  int64
+}
+
+func (c *Config) RegisterFlags(fs *pflag.FlagSet, prefix string) {
+	flag.StringVarEnv(fs, &c.GenerationFile, prefix, "reload-generation-file", "", "reload data when the content of this file changes (disabled if empty)")
+	flag.Int64VarEnv(fs, &c.CheckInterval, prefix, "reload-check-interval-seconds", 30, "check the generation file every N seconds")
+}
+
+// Func should build the new data and swap it in, returning once the old data is no longer in use.
+type Func func(ctx context.Context) error
+
+func NewReloader(logger *zap.Logger, cfg Config, reload Func) *Reloader {
+	return &Reloader{
+		logger: logger.With(zap.String("component", "reloader")),
+		cfg:    cfg,
+		reload: reload,
+		stop:   make(chan struct{}),
+	}
+}
+
+// Reloader triggers a reload when the process receives a SIGHUP, when the generation file changes or when
+// Reload is called directly e.g. by an RPC. Only one reload may run at a time.
+type Reloader struct {
+	logger     *zap.Logger
+	cfg        Config
+	reload     Func
+	lock       sync.Mutex
+	stop       chan struct{}
+	generation string
+}
+
+func (r *Reloader) Reload(ctx context.Context) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.logger.Info("Reloading data...")
+	startTime := time.Now()
+	if err := r.reload(ctx); err != nil {
+		return errors.Wrap(err, "reload failed")
+	}
+	r.logger.Info("Reload completed", zap.Duration("time_taken", time.Since(startTime)))
+	return nil
+}
+
+func (r *Reloader) Start() error {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	var checkGeneration <-chan time.Time
+	if r.cfg.GenerationFile != "" {
+		var err error
+		if r.generation, err = readGeneration(r.cfg.GenerationFile); err != nil {
+			return err
+		}
+		ticker := time.NewTicker(time.Second * time.Duration(r.cfg.CheckInterval))
+		defer ticker.Stop()
+		checkGeneration = ticker.C
+	}
+
+	r.logger.Info("Starting reloader...", zap.String("generation", r.generation))
+	for {
+		select {
+		case <-hup:
+			r.logger.Info("Received SIGHUP")
+			if err := r.Reload(context.Background()); err != nil {
+				r.logger.Error("Failed to reload data", zap.Error(err))
+			}
+		case <-checkGeneration:
+			generation, err := readGeneration(r.cfg.GenerationFile)
+			if err != nil {
+				r.logger.Error("Failed to read generation file", zap.Error(err))
+				continue
+			}
+			if generation == r.generation {
+				continue
+			}
+			r.logger.Info("Generation changed", zap.String("from", r.generation), zap.String("to", generation))
+			if err := r.Reload(context.Background()); err != nil {
+				// the generation is not updated so the reload will be retried on the next check.
+				r.logger.Error("Failed to reload data", zap.Error(err))
+				continue
+			}
+			r.generation = generation
+		case <-r.stop:
+			return nil
+		}
+	}
+}
+
+func (r *Reloader) Stop() {
+	r.logger.Info("Stopping reloader...")
+	close(r.stop)
+}
+
+// readGeneration returns an empty generation if the file does not exist yet.
+func readGeneration(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", errors.Wrap(err, "failed to read generation file")
+	}
+	return strings.TrimSpace(string(b)), nil
+}
@@ -0,0 +1,148 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/karlseguin/ccache/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/filter"
+	"github.com/warmans/rsk-search/pkg/models"
+)
+
+// cacheTTL is a backstop, cached results are normally invalidated by the index generation changing.
+const cacheTTL = time.Hour * 24
+
+// GenerationFunc returns a value that changes whenever the underlying index is replaced.
+type GenerationFunc func() uint64
+
+// CacheSearcher caches up to size Search and PredictSearchTerms results. All results are discarded when the
+// generation changes. Cached results are shared so must not be modified by the caller.
+func CacheSearcher(s Searcher, size int64, generation GenerationFunc) Searcher {
+	return &CachedSearcher{
+		s:          s,
+		cache:      ccache.New(ccache.Configure().MaxSize(size).ItemsToPrune(uint32(max(size/10, 1)))),
+		generation: generation,
+		m:          newCacheMetrics(),
+	}
+}
+
+type CachedSearcher struct {
+	s          Searcher
+	cache      *ccache.Cache
+	generation GenerationFunc
+	// lastGeneration is the generation of the currently cached results.
+	lastGeneration atomic.Uint64
+	m              *cacheMetrics
+}
+
+type cachedResult struct {
+	generation uint64
+	value      any
+}
+
+func (c *CachedSearcher) Search(ctx context.Context, req Request) (*api.SearchResultList, error) {
+	// explanations are for debugging so should always reflect a real query.
+	if req.Explain {
+		return c.s.Search(ctx, req)
+	}
+	facets := slices.Clone(req.Facets)
+	slices.Sort(facets)
+	key := fmt.Sprintf(
+		"search:%q:%q:%v:%d:%d:%q:%d:%v",
+		filter.MustPrint(req.Filter),
+		req.Sort,
+		facets,
+		req.Page,
+		req.PageSize,
+		req.SearchAfter,
+		req.ContextLines,
+		req.DisableAliases,
+	)
+	res, err := c.fetch("search", key, func() (any, error) {
+		return c.s.Search(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*api.SearchResultList), nil
+}
+
+func (c *CachedSearcher) SearchSequence(ctx context.Context, f filter.Filter, steps []filter.Filter, window int32, page int32, pageSize int32, sortBy string, contextLines int32) (*api.SearchResultList, error) {
+	return c.s.SearchSequence(ctx, f, steps, window, page, pageSize, sortBy, contextLines)
+}
+
+func (c *CachedSearcher) SearchSimilar(ctx context.Context, dialogID string, maxResults int32) (*api.SearchResultList, error) {
+	return c.s.SearchSimilar(ctx, dialogID, maxResults)
+}
+
+func (c *CachedSearcher) PredictSearchTerms(ctx context.Context, prefix string, exact bool, numPredictions int32, f filter.Filter) (*api.SearchTermPredictions, error) {
+	key := fmt.Sprintf("predict:%q:%v:%d:%q", prefix, exact, numPredictions, filter.MustPrint(f))
+	res, err := c.fetch("predict", key, func() (any, error) {
+		return c.s.PredictSearchTerms(ctx, prefix, exact, numPredictions, f)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*api.SearchTermPredictions), nil
+}
+
+func (c *CachedSearcher) ListTerms(fieldName string, prefix string) (models.FieldValues, error) {
+	return c.s.ListTerms(fieldName, prefix)
+}
+
+// fetch returns the cached value for the key or calls fetchFn and caches the result if it did not fail.
+func (c *CachedSearcher) fetch(method string, key string, fetchFn func() (any, error)) (any, error) {
+	generation := c.generation()
+	if c.lastGeneration.Swap(generation) != generation {
+		c.cache.Clear()
+	}
+	if item := c.cache.Get(key); item != nil && !item.Expired() {
+		if cached := item.Value().(cachedResult); cached.generation == generation {
+			c.m.hits.WithLabelValues(method).Inc()
+			return cached.value, nil
+		}
+	}
+	c.m.misses.WithLabelValues(method).Inc()
+
+	value, err := fetchFn()
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Set(key, cachedResult{generation: generation, value: value}, cacheTTL)
+	return value, nil
+}
+
+type cacheMetrics struct {
+	hits   *prometheus.CounterVec
+	misses *prometheus.CounterVec
+}
+
+func newCacheMetrics() *cacheMetrics {
+	m := &cacheMetrics{
+		hits: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: "search",
+				Subsystem: "cache",
+				Name:      "hits_total",
+				Help:      "Num searches served from the cache",
+			},
+			[]string{"method"},
+		),
+		misses: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: "search",
+				Subsystem: "cache",
+				Name:      "misses_total",
+				Help:      "Num searches not found in the cache",
+			},
+			[]string{"method"},
+		),
+	}
+	prometheus.DefaultRegisterer.MustRegister(m.hits, m.misses)
+	return m
+}
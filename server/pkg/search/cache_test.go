@@ -0,0 +1,82 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/filter"
+	"github.com/warmans/rsk-search/pkg/models"
+)
+
+type countingSearcher struct {
+	calls int
+	err   error
+}
+
+func (c *countingSearcher) Search(ctx context.Context, req Request) (*api.SearchResultList, error) {
+	c.calls++
+	return &api.SearchResultList{ResultCount: int32(c.calls)}, c.err
+}
+
+func (c *countingSearcher) SearchSequence(ctx context.Context, f filter.Filter, steps []filter.Filter, window int32, page int32, pageSize int32, sortBy string, contextLines int32) (*api.SearchResultList, error) {
+	return nil, nil
+}
+
+func (c *countingSearcher) SearchSimilar(ctx context.Context, dialogID string, maxResults int32) (*api.SearchResultList, error) {
+	return nil, nil
+}
+
+func (c *countingSearcher) PredictSearchTerms(ctx context.Context, prefix string, exact bool, numPredictions int32, f filter.Filter) (*api.SearchTermPredictions, error) {
+	c.calls++
+	return &api.SearchTermPredictions{Prefix: prefix}, c.err
+}
+
+func (c *countingSearcher) ListTerms(fieldName string, prefix string) (models.FieldValues, error) {
+	return nil, nil
+}
+
+func TestCachedSearcher(t *testing.T) {
+	ctx := context.Background()
+	inner := &countingSearcher{}
+	generation := uint64(1)
+	searcher := CacheSearcher(inner, 10, func() uint64 { return generation })
+
+	search := func(req Request) int32 {
+		res, err := searcher.Search(ctx, req)
+		require.NoError(t, err)
+		return res.ResultCount
+	}
+
+	// equivalent filters share a cache entry
+	require.EqualValues(t, 1, search(Request{Filter: filter.MustParse(`actor = "karl"`), Facets: []string{"series", "year"}}))
+	require.EqualValues(t, 1, search(Request{Filter: filter.MustParse(`actor   =   "karl"`), Facets: []string{"year", "series"}}))
+	require.EqualValues(t, 1, inner.calls)
+
+	// different pages do not
+	require.EqualValues(t, 2, search(Request{Filter: filter.MustParse(`actor = "karl"`), Page: 1}))
+
+	// explanations are never cached
+	require.EqualValues(t, 3, search(Request{Filter: filter.MustParse(`actor = "karl"`), Page: 1, Explain: true}))
+
+	// predictions are cached separately
+	_, err := searcher.PredictSearchTerms(ctx, "foo", false, 10, nil)
+	require.NoError(t, err)
+	_, err = searcher.PredictSearchTerms(ctx, "foo", false, 10, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, inner.calls)
+
+	// everything is invalidated when the generation changes
+	generation = 2
+	require.EqualValues(t, 5, search(Request{Filter: filter.MustParse(`actor = "karl"`), Page: 1}))
+	require.EqualValues(t, 5, search(Request{Filter: filter.MustParse(`actor = "karl"`), Page: 1}))
+
+	// errors are not cached
+	inner.err = errors.New("failed")
+	_, err = searcher.Search(ctx, Request{Page: 2})
+	require.Error(t, err)
+	inner.err = nil
+	require.EqualValues(t, 7, search(Request{Page: 2}))
+}
@@ -0,0 +1,62 @@
+package fts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/warmans/rsk-search/pkg/store/ro"
+)
+
+// CreateIndex (re)builds the full text index from the dialog table, dropping any existing index first so that a
+// reloaded DB never serves a stale index. The index is always built from scratch rather than being maintained.
+// The sqlite driver must be built with the sqlite_fts5 tag.
+func CreateIndex(ctx context.Context, conn *ro.Conn) error {
+	return conn.WithTx(func(tx *sqlx.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DROP TABLE IF EXISTS dialog_fts_vocab; DROP TABLE IF EXISTS dialog_fts;`); err != nil {
+			return fmt.Errorf("failed to drop existing index: %w", err)
+		}
+		// episode fields are denormalized into the index as FTS5 MATCH expressions can only reference
+		// columns of the FTS table.
+		_, err := tx.ExecContext(ctx, `
+			CREATE VIRTUAL TABLE dialog_fts USING fts5(
+				content,
+				actor,
+				transcript_id,
+				publication,
+				series,
+				episode,
+				type,
+				actor_key,
+				transcript_id_key,
+				publication_key,
+				type_key,
+				id UNINDEXED,
+				pos UNINDEXED,
+				release_date UNINDEXED,
+				tokenize = 'unicode61 remove_diacritics 2'
+			);
+			CREATE VIRTUAL TABLE dialog_fts_vocab USING fts5vocab(dialog_fts, col);
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create index: %w", err)
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO dialog_fts (
+				content, actor, transcript_id, publication, series, episode, type,
+				actor_key, transcript_id_key, publication_key, type_key,
+				id, pos, release_date
+			)
+			SELECT
+				d.content, d.actor, d.episode_id, e.publication, e.series, e.episode, d.type,
+				'k' || hex(d.actor), 'k' || hex(d.episode_id), 'k' || hex(e.publication), 'k' || hex(d.type),
+				d.id, d.pos, e.release_date
+			FROM dialog d
+			LEFT JOIN episode e ON d.episode_id = e.id
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to populate index: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,326 @@
+package fts
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/data"
+	"github.com/warmans/rsk-search/pkg/filter"
+	"github.com/warmans/rsk-search/pkg/filter/fts5"
+	"github.com/warmans/rsk-search/pkg/meta"
+	"github.com/warmans/rsk-search/pkg/models"
+	"github.com/warmans/rsk-search/pkg/search"
+	v2 "github.com/warmans/rsk-search/pkg/search/v2"
+	"github.com/warmans/rsk-search/pkg/store/ro"
+	"go.uber.org/zap"
+)
+
+const (
+	PageSize = 10
+	// maxTerms limits the number of values returned by ListTerms.
+	maxTerms = 500
+)
+
+// filterMapping maps search fields to the columns of the FTS table.
+var filterMapping = map[string]string{
+	"content":       "content",
+	"actor":         "actor",
+	"transcript_id": "transcript_id",
+	"publication":   "publication",
+	"series":        "series",
+	"episode":       "episode",
+	"type":          "type",
+}
+
+// keyMapping maps keyword fields to columns containing the whole value as a single token so they can be matched
+// exactly. The plain columns are tokenized so e.g. "karl" would also match the actor "steve and karl".
+var keyMapping = map[string]string{
+	"actor":         "actor_key",
+	"transcript_id": "transcript_id_key",
+	"publication":   "publication_key",
+	"type":          "type_key",
+}
+
+// NewSearch creates a searcher using the full text index in the read-only DB. The index must have been created
+// with CreateIndex.
+func NewSearch(conn *ro.Conn, episodeCache *data.EpisodeCache, logger *zap.Logger) *Search {
+	return &Search{conn: conn, episodeCache: episodeCache, logger: logger}
+}
+
+// Search is a simpler alternative to the bluge searcher. Only dialog is indexed and facets, cursors,
+// sequences and similarity searches are not supported.
+type Search struct {
+	conn         *ro.Conn
+	episodeCache *data.EpisodeCache
+	logger       *zap.Logger
+	generation   atomic.Uint64
+}
+
+// IndexSwapped must be called after the read-only DB has been replaced so that cached results are discarded.
+func (s *Search) IndexSwapped() {
+	s.generation.Add(1)
+}
+
+// IndexGeneration is incremented each time the index is swapped so results from a previous index can be discarded.
+func (s *Search) IndexGeneration() uint64 {
+	return s.generation.Load()
+}
+
+func (s *Search) Search(ctx context.Context, searchReq search.Request) (*api.SearchResultList, error) {
+	if len(searchReq.Facets) > 0 {
+		return nil, errors.Wrap(search.ErrUnsupported, "facets")
+	}
+	if searchReq.SearchAfter != "" {
+		return nil, errors.Wrap(search.ErrUnsupported, "search_after")
+	}
+
+	where, params, err := matchClause(searchReq.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := PageSize
+	if searchReq.PageSize > 0 {
+		pageSize = int(searchReq.PageSize)
+	}
+	score := "0"
+	if where != "" {
+		// bm25 is lower for better matches.
+		score = "-bm25(dialog_fts)"
+	}
+	var orderBy string
+	switch searchReq.Sort {
+	case "date":
+		orderBy = "release_date ASC, transcript_id, pos"
+	case "-date":
+		orderBy = "release_date DESC, transcript_id, pos"
+	default:
+		if where != "" {
+			orderBy = "rank, id"
+		} else {
+			orderBy = "release_date ASC, transcript_id, pos"
+		}
+	}
+
+	res := &api.SearchResultList{
+		Results: []*api.SearchResult{},
+		Stats:   map[string]*api.SearchStats{},
+		Facets:  []*api.Facet{},
+	}
+	if searchReq.Explain {
+		res.Explanation = &api.SearchExplanation{Filter: filter.MustPrint(searchReq.Filter)}
+		if len(params) > 0 {
+			res.Explanation.Query = params[0].(string)
+		}
+	}
+
+	hits := []search.Hit{}
+	actorCounts := []struct {
+		Actor        string `db:"actor"`
+		TranscriptID string `db:"transcript_id"`
+		Count        int32  `db:"count"`
+	}{}
+	err = s.conn.WithTx(func(tx *sqlx.Tx) error {
+		if err := tx.GetContext(ctx, &res.ResultCount, fmt.Sprintf(`SELECT COUNT(*) FROM dialog_fts %s`, where), params...); err != nil {
+			return errors.Wrap(err, "failed to count results")
+		}
+		if err := tx.SelectContext(
+			ctx,
+			&hits,
+			fmt.Sprintf(`SELECT id, transcript_id, %s AS score FROM dialog_fts %s ORDER BY %s LIMIT %d OFFSET %d`, score, where, orderBy, pageSize, pageSize*int(searchReq.Page)),
+			params...,
+		); err != nil {
+			return errors.Wrap(err, "failed to select results")
+		}
+		if err := tx.SelectContext(
+			ctx,
+			&actorCounts,
+			fmt.Sprintf(`SELECT actor, transcript_id, COUNT(*) AS count FROM dialog_fts %s GROUP BY actor, transcript_id`, where),
+			params...,
+		); err != nil {
+			return errors.Wrap(err, "failed to select stats")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// fill in gaps in the episode stats to give a complete time-series
+	episodeCounts := map[string]map[string]int32{}
+	for _, c := range actorCounts {
+		if c.Actor == "" {
+			continue
+		}
+		if _, ok := episodeCounts[c.Actor]; !ok {
+			episodeCounts[c.Actor] = map[string]int32{}
+		}
+		episodeCounts[c.Actor][strings.TrimPrefix(c.TranscriptID, "ep-")] = c.Count
+	}
+	for actor, counts := range episodeCounts {
+		stats := &api.SearchStats{Labels: []string{}, Values: []float32{}}
+		for _, episodeID := range meta.EpisodeList() {
+			stats.Labels = append(stats.Labels, episodeID)
+			stats.Values = append(stats.Values, float32(counts[episodeID]))
+		}
+		res.Stats[actor] = stats
+	}
+
+	contextLines := search.DefaultContextLines
+	if searchReq.ContextLines > 0 {
+		contextLines = int(searchReq.ContextLines)
+	}
+	if res.Results, err = search.HitResults(s.episodeCache, s.logger, hits, contextLines, nil); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *Search) SearchSequence(ctx context.Context, f filter.Filter, steps []filter.Filter, window int32, page int32, pageSize int32, sortBy string, contextLines int32) (*api.SearchResultList, error) {
+	return nil, errors.Wrap(search.ErrUnsupported, "sequence search")
+}
+
+func (s *Search) SearchSimilar(ctx context.Context, dialogID string, maxResults int32) (*api.SearchResultList, error) {
+	return nil, errors.Wrap(search.ErrUnsupported, "similar search")
+}
+
+func (s *Search) PredictSearchTerms(ctx context.Context, prefix string, exact bool, numPredictions int32, f filter.Filter) (*api.SearchTermPredictions, error) {
+
+	prefix = strings.TrimSpace(prefix)
+	if f == nil && prefix == "" {
+		return &api.SearchTermPredictions{}, nil
+	}
+	if prefix != "" {
+		var prefixFilter filter.Filter
+		if exact {
+			prefixFilter = filter.Eq("content", filter.String(prefix))
+		} else {
+			prefixFilter = filter.Like("content", filter.String(prefix))
+		}
+		if f == nil {
+			f = prefixFilter
+		} else {
+			f = filter.And(f, prefixFilter)
+		}
+	}
+	where, params, err := matchClause(f)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []struct {
+		TranscriptID string `db:"transcript_id"`
+		Pos          int32  `db:"pos"`
+		Actor        string `db:"actor"`
+		Content      string `db:"content"`
+		Fragment     string `db:"fragment"`
+	}{}
+	err = s.conn.WithTx(func(tx *sqlx.Tx) error {
+		// fetch extras in case some need to be discarded
+		return tx.SelectContext(
+			ctx,
+			&rows,
+			fmt.Sprintf(
+				`SELECT transcript_id, pos, actor, content, snippet(dialog_fts, 0, '%s', '%s', '[...]', 32) AS fragment FROM dialog_fts %s ORDER BY rank LIMIT %d`,
+				v2.StartIdentifier,
+				v2.EndIdentifier,
+				where,
+				max(int(numPredictions), 50),
+			),
+			params...,
+		)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "search failed")
+	}
+
+	predictions := &api.SearchTermPredictions{
+		Prefix:      prefix,
+		Predictions: []*api.Prediction{},
+	}
+	for _, row := range rows {
+		// de-duplicate results
+		duplicate := !stringsAreNotTooSimilar(prefix, row.Content)
+		for _, v := range predictions.Predictions {
+			if !duplicate {
+				duplicate = !stringsAreNotTooSimilar(v.Line, row.Content)
+			}
+		}
+		if duplicate {
+			continue
+		}
+		predictions.Predictions = append(predictions.Predictions, &api.Prediction{
+			Line:     row.Content,
+			Fragment: row.Fragment,
+			Actor:    row.Actor,
+			Epid:     row.TranscriptID,
+			Pos:      row.Pos,
+		})
+		if len(predictions.Predictions) >= int(numPredictions) {
+			break
+		}
+	}
+	return predictions, nil
+}
+
+func (s *Search) ListTerms(fieldName string, prefix string) (models.FieldValues, error) {
+	col, ok := filterMapping[fieldName]
+	if !ok {
+		return nil, fmt.Errorf("unknown field: %s", fieldName)
+	}
+
+	terms := models.FieldValues{}
+	err := s.conn.WithTx(func(tx *sqlx.Tx) error {
+		if col == "content" {
+			// content is tokenized so the values are the indexed terms which are always lowercase.
+			prefix = strings.ToLower(prefix)
+			return tx.Select(
+				&terms,
+				`SELECT term AS value, doc AS count FROM dialog_fts_vocab WHERE col = $1 AND term >= $2 AND term < $3 ORDER BY doc DESC LIMIT $4`,
+				col,
+				prefix,
+				prefix+string(utf8.MaxRune),
+				maxTerms+1,
+			)
+		}
+		return tx.Select(
+			&terms,
+			fmt.Sprintf(`SELECT %s AS value, COUNT(*) AS count FROM dialog_fts WHERE %s LIKE $1 ESCAPE '\' AND %s != '' GROUP BY %s ORDER BY count DESC LIMIT $2`, col, col, col, col),
+			strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(prefix)+"%",
+			maxTerms+1,
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(terms) > maxTerms {
+		return nil, fmt.Errorf("too many terms for field '%s' returned (prefix: %s)", fieldName, prefix)
+	}
+	sort.SliceStable(terms, func(i, j int) bool {
+		return terms[i].Count > terms[j].Count
+	})
+	return terms, nil
+}
+
+// matchClause creates a where clause for the filter. A nil filter matches everything.
+func matchClause(f filter.Filter) (string, []interface{}, error) {
+	if f == nil {
+		return "", []interface{}{}, nil
+	}
+	match, err := fts5.FilterToMatch(f, filterMapping, keyMapping)
+	if err != nil {
+		return "", nil, errors.Wrap(search.ErrUnsupported, err.Error())
+	}
+	return "WHERE dialog_fts MATCH $1", []interface{}{match}, nil
+}
+
+func stringsAreNotTooSimilar(search, found string) bool {
+	return strings.Trim(strings.ToLower(search), ".?,!") != strings.Trim(strings.ToLower(found), ".?,!")
+}
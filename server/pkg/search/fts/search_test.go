@@ -0,0 +1,234 @@
+//go:build sqlite_fts5
+
+package fts
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/pkg/data"
+	"github.com/warmans/rsk-search/pkg/filter"
+	"github.com/warmans/rsk-search/pkg/models"
+	"github.com/warmans/rsk-search/pkg/models/modelstest"
+	"github.com/warmans/rsk-search/pkg/search"
+	"github.com/warmans/rsk-search/pkg/store/common"
+	"github.com/warmans/rsk-search/pkg/store/ro"
+	"go.uber.org/zap"
+)
+
+func datedTranscript(episode int32, releaseDate time.Time, lines ...[2]string) *models.Transcript {
+	ep := modelstest.Transcript(episode, lines...)
+	ep.ReleaseDate = &releaseDate
+	return ep
+}
+
+func testSearch(t *testing.T, transcripts ...*models.Transcript) *Search {
+	dir := t.TempDir()
+	for _, ep := range transcripts {
+		require.NoError(t, data.SaveEpisodeToFile(dir, ep))
+	}
+	episodeCache, err := data.NewEpisodeStore(dir)
+	require.NoError(t, err)
+
+	conn, err := ro.NewConn(&common.Config{DSN: path.Join(dir, "ro.sqlite3")})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	require.NoError(t, conn.Migrate())
+	require.NoError(t, conn.WithStore(func(s *ro.Store) error {
+		for _, ep := range transcripts {
+			if err := s.InsertEpisodeWithTranscript(context.Background(), ep); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	require.NoError(t, CreateIndex(context.Background(), conn))
+	// creating the index again rebuilds it
+	require.NoError(t, CreateIndex(context.Background(), conn))
+
+	return NewSearch(conn, episodeCache, zap.NewNop())
+}
+
+func TestSearch(t *testing.T) {
+	s := testSearch(
+		t,
+		datedTranscript(1, time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC), [2]string{"karl", "a monkey news story"}, [2]string{"ricky", "monkey news"}, [2]string{"steve", "go on then"}),
+		datedTranscript(2, time.Date(2001, 1, 8, 0, 0, 0, 0, time.UTC), [2]string{"karl", "the little monkey"}, [2]string{"steve", "what about the monkey"}),
+	)
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		req         search.Request
+		expectCount int32
+		expectIDs   []string
+		expectErr   error
+	}{
+		{
+			name:        "content",
+			req:         search.Request{Filter: filter.Eq("content", filter.String("monkey news")), Sort: "date"},
+			expectCount: 2,
+			expectIDs:   []string{"ep-xfm-S1E01-1", "ep-xfm-S1E01-2"},
+		},
+		{
+			name:        "content sorted by date desc",
+			req:         search.Request{Filter: filter.Like("content", filter.String("monkey")), Sort: "-date"},
+			expectCount: 4,
+			expectIDs:   []string{"ep-xfm-S1E02-1", "ep-xfm-S1E02-2", "ep-xfm-S1E01-1", "ep-xfm-S1E01-2"},
+		},
+		{
+			name:        "negated actor",
+			req:         search.Request{Filter: filter.And(filter.Like("content", filter.String("monkey")), filter.Neq("actor", filter.String("karl"))), Sort: "date"},
+			expectCount: 2,
+			expectIDs:   []string{"ep-xfm-S1E01-2", "ep-xfm-S1E02-2"},
+		},
+		{
+			name:        "near",
+			req:         search.Request{Filter: filter.Near("content", filter.Proximity("little monkey", 1)), Sort: "date"},
+			expectCount: 1,
+			expectIDs:   []string{"ep-xfm-S1E02-1"},
+		},
+		{
+			name:        "paged",
+			req:         search.Request{Filter: filter.Like("content", filter.String("monkey")), Sort: "date", PageSize: 3, Page: 1},
+			expectCount: 4,
+			expectIDs:   []string{"ep-xfm-S1E02-2"},
+		},
+		{
+			name:        "no filter",
+			req:         search.Request{},
+			expectCount: 5,
+			expectIDs:   []string{"ep-xfm-S1E01-1", "ep-xfm-S1E01-2", "ep-xfm-S1E01-3", "ep-xfm-S1E02-1", "ep-xfm-S1E02-2"},
+		},
+		{
+			name:      "unsupported filter",
+			req:       search.Request{Filter: filter.Gt("series", filter.Int(1))},
+			expectErr: search.ErrUnsupported,
+		},
+		{
+			name:      "unsupported facets",
+			req:       search.Request{Facets: []string{"series"}},
+			expectErr: search.ErrUnsupported,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := s.Search(ctx, test.req)
+			if test.expectErr != nil {
+				require.ErrorIs(t, err, test.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.EqualValues(t, test.expectCount, res.ResultCount)
+			ids := []string{}
+			for _, r := range res.Results {
+				for _, d := range r.Dialogs[0].Transcript {
+					if d.IsMatchedRow {
+						ids = append(ids, d.Id)
+					}
+				}
+			}
+			require.EqualValues(t, test.expectIDs, ids)
+		})
+	}
+}
+
+func TestSearchKeywordsMatchExactly(t *testing.T) {
+	s := testSearch(
+		t,
+		datedTranscript(1, time.Now(), [2]string{"karl", "monkey news"}, [2]string{"steve and karl", "monkey"}, [2]string{"steve", "go on"}),
+	)
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		filter    filter.Filter
+		expectIDs []string
+	}{
+		{
+			name:      "eq",
+			filter:    filter.Eq("actor", filter.String("karl")),
+			expectIDs: []string{"ep-xfm-S1E01-1"},
+		},
+		{
+			name:      "neq",
+			filter:    filter.And(filter.Like("content", filter.String("monkey")), filter.Neq("actor", filter.String("karl"))),
+			expectIDs: []string{"ep-xfm-S1E01-2"},
+		},
+		{
+			name:      "in",
+			filter:    filter.In("actor", filter.List(filter.String("karl"), filter.String("steve"))),
+			expectIDs: []string{"ep-xfm-S1E01-1", "ep-xfm-S1E01-3"},
+		},
+		{
+			name:      "like matches any word",
+			filter:    filter.Like("actor", filter.String("karl")),
+			expectIDs: []string{"ep-xfm-S1E01-1", "ep-xfm-S1E01-2"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := s.Search(ctx, search.Request{Filter: test.filter, Sort: "date"})
+			require.NoError(t, err)
+			ids := []string{}
+			for _, r := range res.Results {
+				for _, d := range r.Dialogs[0].Transcript {
+					if d.IsMatchedRow {
+						ids = append(ids, d.Id)
+					}
+				}
+			}
+			require.ElementsMatch(t, test.expectIDs, ids)
+		})
+	}
+}
+
+func TestListTerms(t *testing.T) {
+	s := testSearch(
+		t,
+		datedTranscript(1, time.Now(), [2]string{"karl", "monkey news"}, [2]string{"karl", "monkeys"}, [2]string{"steve", "go on"}),
+	)
+
+	terms, err := s.ListTerms("actor", "k")
+	require.NoError(t, err)
+	require.EqualValues(t, models.FieldValues{{Value: "karl", Count: 2}}, terms)
+
+	terms, err = s.ListTerms("content", "Monk")
+	require.NoError(t, err)
+	require.EqualValues(t, models.FieldValues{{Value: "monkey", Count: 1}, {Value: "monkeys", Count: 1}}, terms)
+
+	_, err = s.ListTerms("foo", "")
+	require.Error(t, err)
+}
+
+func TestCreateIndexRebuilds(t *testing.T) {
+	s := testSearch(t, datedTranscript(1, time.Now(), [2]string{"karl", "monkey news"}))
+
+	require.NoError(t, s.conn.WithStore(func(st *ro.Store) error {
+		return st.InsertEpisodeWithTranscript(context.Background(), datedTranscript(2, time.Now(), [2]string{"steve", "go on"}))
+	}))
+	require.NoError(t, CreateIndex(context.Background(), s.conn))
+
+	terms, err := s.ListTerms("actor", "")
+	require.NoError(t, err)
+	require.ElementsMatch(t, models.FieldValues{{Value: "karl", Count: 1}, {Value: "steve", Count: 1}}, terms)
+}
+
+func TestPredictSearchTerms(t *testing.T) {
+	s := testSearch(
+		t,
+		datedTranscript(1, time.Now(), [2]string{"karl", "a monkey news story"}, [2]string{"steve", "monkey news"}, [2]string{"karl", "what"}),
+	)
+
+	res, err := s.PredictSearchTerms(context.Background(), "monkey news", true, 10, filter.Eq("actor", filter.String("karl")))
+	require.NoError(t, err)
+	require.Len(t, res.Predictions, 1)
+	require.EqualValues(t, "a monkey news story", res.Predictions[0].Line)
+	require.EqualValues(t, "a {{monkey news}} story", res.Predictions[0].Fragment)
+	require.EqualValues(t, "ep-xfm-S1E01", res.Predictions[0].Epid)
+	require.EqualValues(t, 1, res.Predictions[0].Pos)
+}
@@ -24,16 +24,61 @@ type InstrumentedSearcher struct {
 	logger *zap.Logger
 }
 
-func (i *InstrumentedSearcher) Search(ctx context.Context, f filter.Filter, page int32, sortBy string) (*api.SearchResultList, error) {
+func (i *InstrumentedSearcher) Search(ctx context.Context, req Request) (*api.SearchResultList, error) {
 	startTime := time.Now().UnixMilli()
 	defer func() {
 		taken := float64(time.Now().UnixMilli()-startTime) / 1000
 		i.m.queryDurationSeconds.Observe(taken)
 		if taken > SlowQueryThresholdSeconds {
-			i.logger.Warn("Slow search query detected", zap.String("filter", filter.MustPrint(f)), zap.Int32("page", page))
+			i.logger.Warn(
+				"Slow search query detected",
+				zap.String("filter", filter.MustPrint(req.Filter)),
+				zap.Int32("page", req.Page),
+				zap.Int32("page_size", req.PageSize),
+				zap.Bool("search_after", req.SearchAfter != ""),
+			)
+		}
+	}()
+	return i.s.Search(ctx, req)
+}
+
+func (i *InstrumentedSearcher) SearchSequence(ctx context.Context, f filter.Filter, steps []filter.Filter, window int32, page int32, pageSize int32, sortBy string, contextLines int32) (*api.SearchResultList, error) {
+	startTime := time.Now().UnixMilli()
+	defer func() {
+		taken := float64(time.Now().UnixMilli()-startTime) / 1000
+		i.m.sequenceDurationSeconds.Observe(taken)
+		if taken > SlowQueryThresholdSeconds {
+			printedSteps := make([]string, len(steps))
+			for k, step := range steps {
+				printedSteps[k] = filter.MustPrint(step)
+			}
+			i.logger.Warn(
+				"Slow sequence query detected",
+				zap.String("filter", filter.MustPrint(f)),
+				zap.Strings("steps", printedSteps),
+				zap.Int32("window", window),
+				zap.Int32("page", page),
+				zap.Int32("page_size", pageSize),
+			)
 		}
 	}()
-	return i.s.Search(ctx, f, page, sortBy)
+	return i.s.SearchSequence(ctx, f, steps, window, page, pageSize, sortBy, contextLines)
+}
+
+func (i *InstrumentedSearcher) SearchSimilar(ctx context.Context, dialogID string, maxResults int32) (*api.SearchResultList, error) {
+	startTime := time.Now().UnixMilli()
+	defer func() {
+		taken := float64(time.Now().UnixMilli()-startTime) / 1000
+		i.m.similarDurationSeconds.Observe(taken)
+		if taken > SlowQueryThresholdSeconds {
+			i.logger.Warn(
+				"Slow similar query detected",
+				zap.String("dialog_id", dialogID),
+				zap.Int32("max_results", maxResults),
+			)
+		}
+	}()
+	return i.s.SearchSimilar(ctx, dialogID, maxResults)
 }
 
 func (i *InstrumentedSearcher) PredictSearchTerms(ctx context.Context, prefix string, exact bool, numPredictions int32, f filter.Filter) (*api.SearchTermPredictions, error) {
@@ -72,6 +117,8 @@ func (i *InstrumentedSearcher) ListTerms(fieldName string, prefix string) (model
 
 type metrics struct {
 	queryDurationSeconds      prometheus.Histogram
+	sequenceDurationSeconds   prometheus.Histogram
+	similarDurationSeconds    prometheus.Histogram
 	predictionDurationSeconds prometheus.Histogram
 	listTermsDurationSeconds  prometheus.Histogram
 }
@@ -87,6 +134,24 @@ func newMetrics() *metrics {
 				Buckets:   stdBuckets,
 			},
 		),
+		sequenceDurationSeconds: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: "search",
+				Subsystem: "searcher",
+				Name:      "sequence_duration_seconds",
+				Help:      "Num seconds taken to execute sequence search",
+				Buckets:   stdBuckets,
+			},
+		),
+		similarDurationSeconds: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: "search",
+				Subsystem: "searcher",
+				Name:      "similar_duration_seconds",
+				Help:      "Num seconds taken to execute similar line search",
+				Buckets:   stdBuckets,
+			},
+		),
 		predictionDurationSeconds: prometheus.NewHistogram(
 			prometheus.HistogramOpts{
 				Namespace: "search",
@@ -108,6 +173,8 @@ func newMetrics() *metrics {
 	}
 	prometheus.DefaultRegisterer.MustRegister(
 		m.queryDurationSeconds,
+		m.sequenceDurationSeconds,
+		m.similarDurationSeconds,
 		m.predictionDurationSeconds,
 		m.listTermsDurationSeconds,
 	)
@@ -0,0 +1,86 @@
+package search
+
+import (
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/data"
+	"github.com/warmans/rsk-search/pkg/models"
+	"go.uber.org/zap"
+)
+
+// DefaultContextLines is the number of lines either side of a matched line included in a result if not specified.
+const DefaultContextLines = 3
+
+// DialogResult returns the matched line with the surrounding lines as context, or nil if it no longer exists.
+func DialogResult(ep *models.Transcript, dialogID string, score float64, contextLines int) *api.SearchResult {
+	idx := slices.IndexFunc(ep.Transcript, func(d models.Dialog) bool {
+		return d.ID == dialogID
+	})
+	if idx == -1 {
+		return nil
+	}
+	lines := []*api.Dialog{}
+	for _, d := range ep.Transcript[max(0, idx-contextLines):min(len(ep.Transcript), idx+contextLines+1)] {
+		lines = append(lines, d.Proto(d.ID == dialogID))
+	}
+	return &api.SearchResult{
+		Episode: ep.ShortProto(false),
+		Dialogs: []*api.DialogResult{{Transcript: lines, Score: float32(score)}},
+	}
+}
+
+// Hit is a matched document. Results are only built once all the hits for a page have been collected
+// so that each transcript is only fetched once.
+type Hit struct {
+	ID           string  `db:"id"`
+	TranscriptID string  `db:"transcript_id"`
+	Score        float64 `db:"score"`
+	// DocType is empty or DocTypeDialog for lines of dialog.
+	DocType     string                `db:"-"`
+	Explanation *api.ScoreExplanation `db:"-"`
+}
+
+// EntityResultFunc returns the result for a hit that is not a line of dialog, or nil if it no longer exists.
+type EntityResultFunc func(ep *models.Transcript, entityID string, score float64) *api.SearchResult
+
+// HitResults creates a result for each hit in the same order. Hits referring to data that no longer exists are skipped.
+// entityResult may be nil if only dialog is indexed.
+func HitResults(
+	episodeCache *data.EpisodeCache,
+	logger *zap.Logger,
+	hits []Hit,
+	contextLines int,
+	entityResult EntityResultFunc,
+) ([]*api.SearchResult, error) {
+	episodes := map[string]*models.Transcript{}
+	results := []*api.SearchResult{}
+	for _, h := range hits {
+		ep, ok := episodes[h.TranscriptID]
+		if !ok {
+			var err error
+			if ep, err = episodeCache.GetEpisode(h.TranscriptID, false); err != nil && !errors.Is(err, data.ErrNotFound) {
+				return nil, errors.Wrapf(err, "episode ID: %s", h.TranscriptID)
+			}
+			episodes[h.TranscriptID] = ep
+		}
+		if ep == nil {
+			logger.Warn("failed to find episode, is the cache out of sync with the index?", zap.String("episode_id", h.TranscriptID))
+			continue
+		}
+		var result *api.SearchResult
+		if h.DocType == "" || h.DocType == DocTypeDialog {
+			result = DialogResult(ep, h.ID, h.Score, contextLines)
+		} else if entityResult != nil {
+			result = entityResult(ep, h.ID, h.Score)
+		}
+		if result == nil {
+			logger.Warn("failed to find result, is the cache out of sync with the index?", zap.String("id", h.ID))
+			continue
+		}
+		result.ScoreExplanation = h.Explanation
+		results = append(results, result)
+	}
+	return results, nil
+}
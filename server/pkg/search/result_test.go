@@ -0,0 +1,61 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/pkg/models"
+)
+
+func TestDialogResult(t *testing.T) {
+	ep := &models.Transcript{Publication: "xfm", Series: 1, Episode: 1}
+	for k, v := range []string{"one", "two", "three", "four", "five"} {
+		ep.Transcript = append(ep.Transcript, models.Dialog{ID: models.DialogID(ep.ID(), int64(k+1)), Position: int64(k + 1), Content: v})
+	}
+
+	tests := []struct {
+		name         string
+		dialogID     string
+		contextLines int
+		expectLines  []string
+	}{
+		{
+			name:         "no context",
+			dialogID:     "ep-xfm-S1E01-3",
+			contextLines: 0,
+			expectLines:  []string{"three"},
+		},
+		{
+			name:         "context on both sides",
+			dialogID:     "ep-xfm-S1E01-3",
+			contextLines: 1,
+			expectLines:  []string{"two", "three", "four"},
+		},
+		{
+			name:         "context truncated at start",
+			dialogID:     "ep-xfm-S1E01-1",
+			contextLines: 2,
+			expectLines:  []string{"one", "two", "three"},
+		},
+		{
+			name:         "context truncated at end",
+			dialogID:     "ep-xfm-S1E01-5",
+			contextLines: 10,
+			expectLines:  []string{"one", "two", "three", "four", "five"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := DialogResult(ep, test.dialogID, 1, test.contextLines)
+			require.NotNil(t, result)
+			lines := []string{}
+			for _, d := range result.Dialogs[0].Transcript {
+				lines = append(lines, d.Content)
+				require.Equal(t, d.Id == test.dialogID, d.IsMatchedRow)
+			}
+			require.EqualValues(t, test.expectLines, lines)
+		})
+	}
+
+	require.Nil(t, DialogResult(ep, "ep-xfm-S1E01-6", 1, 3))
+}
@@ -2,38 +2,93 @@ package search
 
 import (
 	"context"
+	"errors"
 	"github.com/warmans/rsk-search/gen/api"
 	"github.com/warmans/rsk-search/pkg/filter"
 	"github.com/warmans/rsk-search/pkg/models"
 	"time"
 )
 
+// ErrSequenceTooBroad is returned when a sequence search step matches too many lines to be evaluated.
+var ErrSequenceTooBroad = errors.New("sequence step matched too many lines")
+
+// ErrInvalidCursor is returned when a search_after cursor cannot be decoded or was created for a different sort.
+var ErrInvalidCursor = errors.New("invalid search cursor")
+
+// ErrNotFound is returned when a document referenced by ID does not exist in the index.
+var ErrNotFound = errors.New("document not found")
+
+// ErrUnsupported is returned when a search backend does not support some part of a request.
+var ErrUnsupported = errors.New("not supported by search backend")
+
+// Document types in the index. Everything other than dialog refers to a range of dialog in a transcript.
+const (
+	DocTypeDialog   = "dialog"
+	DocTypeSynopsis = "synopsis"
+	DocTypeTrivia   = "trivia"
+	DocTypeTag      = "tag"
+	DocTypeSong     = "song"
+)
+
+// Facets lists the facets that can be requested along with search results.
+var Facets = []string{"publication", "series", "year", "type", "special"}
+
+// Request describes a search for dialog lines.
+type Request struct {
+	Filter filter.Filter
+	Sort   string
+	Facets []string
+	Page   int32
+	// PageSize will use the searcher's default if not set.
+	PageSize int32
+	// SearchAfter is a cursor returned with a previous page of results. If it is set Page is ignored.
+	SearchAfter string
+	// ContextLines is the number of lines before and after each matched line to include in the results. The
+	// searcher's default is used if not set.
+	ContextLines int32
+	// Explain includes the generated query and score explanations in the results.
+	Explain bool
+	// DisableAliases only matches the exact terms in the filter.
+	DisableAliases bool
+}
+
 type Searcher interface {
-	Search(ctx context.Context, f filter.Filter, page int32, sortBy string) (*api.SearchResultList, error)
+	Search(ctx context.Context, req Request) (*api.SearchResultList, error)
+	// SearchSequence finds exchanges of lines matching each of the steps in order.
+	SearchSequence(ctx context.Context, f filter.Filter, steps []filter.Filter, window int32, page int32, pageSize int32, sortBy string, contextLines int32) (*api.SearchResultList, error)
+	// SearchSimilar finds lines from other transcripts with similar content to the given line.
+	SearchSimilar(ctx context.Context, dialogID string, maxResults int32) (*api.SearchResultList, error)
 	// PredictSearchTerms supports auto-complete for the search bar.
 	PredictSearchTerms(ctx context.Context, prefix string, exact bool, numPredictions int32, f filter.Filter) (*api.SearchTermPredictions, error)
 	ListTerms(fieldName string, prefix string) (models.FieldValues, error)
 }
 
 type DialogDocument struct {
-	ID           string     `json:"id"`
-	TranscriptID string     `json:"transcript_id"`
-	Mapping      string     `json:"mapping"`
-	Publication  string     `json:"publication"`
-	Series       int64      `json:"series"`
-	Episode      int64      `json:"episode"`
-	Date         *time.Time `json:"date"`
-	Actor        string     `json:"actor"`
-	Position     int64      `json:"pos"`
-	Content      string     `json:"content"`
-	ContentType  string     `json:"type"`
-	Special      string     `json:"special"`
+	ID                string        `json:"id"`
+	TranscriptID      string        `json:"transcript_id"`
+	TranscriptVersion string        `json:"transcript_version"`
+	Mapping           string        `json:"mapping"`
+	DocType           string        `json:"doc_type"`
+	Publication       string        `json:"publication"`
+	Series            int64         `json:"series"`
+	Episode           int64         `json:"episode"`
+	Date              *time.Time    `json:"date"`
+	Actor             string        `json:"actor"`
+	Position          int64         `json:"pos"`
+	EndPosition       int64         `json:"end_pos"`
+	Offset            time.Duration `json:"offset"`
+	OffsetInferred    bool          `json:"offset_inferred"`
+	Content           string        `json:"content"`
+	ContentType       string        `json:"type"`
+	Special           string        `json:"special"`
 }
 
 func (d DialogDocument) GetNamedField(name string) interface{} {
 	switch name {
 	case "transcript_id":
 		return d.TranscriptID
+	case "transcript_version":
+		return d.TranscriptVersion
 	case "publication":
 		return d.Publication
 	case "series":
@@ -46,7 +101,18 @@ func (d DialogDocument) GetNamedField(name string) interface{} {
 		return d.Actor
 	case "pos":
 		return d.Position
-	case "content":
+	case "end_pos":
+		return d.EndPosition
+	case "offset":
+		return d.Offset.Seconds()
+	case "offset_inferred":
+		if d.OffsetInferred {
+			return int64(1)
+		}
+		return int64(0)
+	case "doc_type":
+		return d.DocType
+	case "content", "content_phonetic":
 		return d.Content
 	case "type":
 		return d.ContentType
@@ -0,0 +1,167 @@
+package v2
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/blugelabs/bluge"
+	"github.com/pkg/errors"
+)
+
+const (
+	// aliasContextTerms is the number of most common terms used to describe the context a term is used in.
+	aliasContextTerms = 2000
+	// aliasContextWindow is the number of terms either side of a term that make up its context.
+	aliasContextWindow = 2
+)
+
+type AliasSuggestion struct {
+	Term string
+	// Similarity is the cosine similarity of the contexts the terms are used in, between 0 and 1.
+	Similarity float64
+	// CoOccurrences is the number of lines that contain both terms. Aliases are rarely used together so a
+	// high number suggests the terms are related rather than equivalent.
+	CoOccurrences int
+}
+
+// SuggestAliases finds terms that are used in similar contexts to the given term i.e. they are said near
+// the same words. These are candidates for the alias dictionary but need to be checked by hand.
+func SuggestAliases(ctx context.Context, reader *bluge.Reader, term string, minCount int, maxResults int) ([]AliasSuggestion, error) {
+	dmi, err := reader.Search(ctx, bluge.NewAllMatches(dialogOnly(bluge.NewMatchAllQuery())))
+	if err != nil {
+		return nil, err
+	}
+	lines := [][]string{}
+	next, err := dmi.Next()
+	for err == nil && next != nil {
+		err = next.VisitStoredFields(func(field string, value []byte) bool {
+			if field == "content" {
+				lines = append(lines, aliasTerms(string(value)))
+			}
+			return true
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "error accessing stored fields")
+		}
+		next, err = dmi.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return suggestAliases(lines, strings.ToLower(term), minCount, maxResults), nil
+}
+
+// aliasTerms returns the significant terms in the content.
+func aliasTerms(content string) []string {
+	terms := []string{}
+	for _, tok := range similarityAnalyzer.Analyze([]byte(content)) {
+		if term := string(tok.Term); len([]rune(term)) >= similarMinTermLength {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+func suggestAliases(lines [][]string, term string, minCount int, maxResults int) []AliasSuggestion {
+	counts := map[string]int{}
+	for _, line := range lines {
+		for _, t := range line {
+			counts[t]++
+		}
+	}
+	if counts[term] == 0 {
+		return []AliasSuggestion{}
+	}
+
+	// the context of a term is how many times it was said near each of the most common terms.
+	contextTerms := make([]string, 0, len(counts))
+	for t := range counts {
+		contextTerms = append(contextTerms, t)
+	}
+	slices.SortFunc(contextTerms, func(a, b string) int {
+		if counts[a] != counts[b] {
+			return counts[b] - counts[a]
+		}
+		return strings.Compare(a, b)
+	})
+	dimensions := map[string]int{}
+	for k, t := range contextTerms[:min(aliasContextTerms, len(contextTerms))] {
+		dimensions[t] = k
+	}
+
+	contexts := map[string]map[int]float64{}
+	coOccurrences := map[string]int{}
+	for _, line := range lines {
+		hasTerm := slices.Contains(line, term)
+		for i, t := range line {
+			if counts[t] < minCount && t != term {
+				continue
+			}
+			if hasTerm && !slices.Contains(line[:i], t) {
+				coOccurrences[t]++
+			}
+			if _, ok := contexts[t]; !ok {
+				contexts[t] = map[int]float64{}
+			}
+			for _, other := range line[max(0, i-aliasContextWindow):min(len(line), i+aliasContextWindow+1)] {
+				if k, ok := dimensions[other]; ok && other != t {
+					contexts[t][k]++
+				}
+			}
+		}
+	}
+
+	// counts are weighted by positive PMI so common terms which appear in every context do not dominate.
+	for t, context := range contexts {
+		for k, v := range context {
+			weight := math.Log(v * float64(len(lines)) / (float64(counts[t]) * float64(counts[contextTerms[k]])))
+			if weight <= 0 {
+				delete(context, k)
+				continue
+			}
+			context[k] = weight
+		}
+	}
+
+	suggestions := []AliasSuggestion{}
+	for t, context := range contexts {
+		if t == term {
+			continue
+		}
+		similarity := cosineSimilarity(contexts[term], context)
+		if similarity <= 0 {
+			continue
+		}
+		suggestions = append(suggestions, AliasSuggestion{Term: t, Similarity: similarity, CoOccurrences: coOccurrences[t]})
+	}
+	slices.SortFunc(suggestions, func(a, b AliasSuggestion) int {
+		if a.Similarity != b.Similarity {
+			if a.Similarity > b.Similarity {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Term, b.Term)
+	})
+	if len(suggestions) > maxResults {
+		suggestions = suggestions[:maxResults]
+	}
+	return suggestions
+}
+
+func cosineSimilarity(a map[int]float64, b map[int]float64) float64 {
+	var dot, normA, normB float64
+	for k, v := range a {
+		dot += v * b[k]
+		normA += v * v
+	}
+	for _, v := range b {
+		normB += v * v
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
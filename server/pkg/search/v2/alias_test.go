@@ -0,0 +1,25 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestAliases(t *testing.T) {
+	lines := [][]string{
+		aliasTerms("I was watching the telly last night"),
+		aliasTerms("nothing on the telly but adverts"),
+		aliasTerms("I was watching the television last night"),
+		aliasTerms("nothing on the television but adverts"),
+		aliasTerms("the monkey was in space"),
+		aliasTerms("the monkey was watching space adverts"),
+	}
+	suggestions := suggestAliases(lines, "telly", 1, 2)
+	require.Len(t, suggestions, 2)
+	require.Equal(t, "television", suggestions[0].Term)
+	require.Equal(t, 0, suggestions[0].CoOccurrences)
+	require.InDelta(t, 1, suggestions[0].Similarity, 0.0001)
+
+	require.Empty(t, suggestAliases(lines, "unknown", 1, 2))
+}
@@ -0,0 +1,38 @@
+package v2
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/warmans/rsk-search/pkg/search"
+)
+
+// cursor is the sort value of the last result of a page. The sort is included since the values
+// are meaningless if the next page is requested with a different sort.
+type cursor struct {
+	Sort  string   `json:"s"`
+	After [][]byte `json:"a"`
+}
+
+func encodeCursor(sortBy string, after [][]byte) (string, error) {
+	b, err := json.Marshal(cursor{Sort: sortBy, After: after})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeCursor(raw string, sortBy string) ([][]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, search.ErrInvalidCursor
+	}
+	c := cursor{}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, search.ErrInvalidCursor
+	}
+	if c.Sort != sortBy || len(c.After) != 2 {
+		return nil, search.ErrInvalidCursor
+	}
+	return c.After, nil
+}
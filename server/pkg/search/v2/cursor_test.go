@@ -0,0 +1,25 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/pkg/search"
+)
+
+func TestCursor(t *testing.T) {
+	after := [][]byte{[]byte("foo"), []byte("ep-xfm-S1E01-12")}
+
+	encoded, err := encodeCursor("_score", after)
+	require.NoError(t, err)
+
+	decoded, err := decodeCursor(encoded, "_score")
+	require.NoError(t, err)
+	require.EqualValues(t, after, decoded)
+
+	_, err = decodeCursor(encoded, "-date")
+	require.ErrorIs(t, err, search.ErrInvalidCursor)
+
+	_, err = decodeCursor("not a cursor", "_score")
+	require.ErrorIs(t, err, search.ErrInvalidCursor)
+}
@@ -0,0 +1,102 @@
+package v2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/models"
+	"github.com/warmans/rsk-search/pkg/search"
+)
+
+// entity is a searchable part of a transcript other than a line of dialog e.g. a synopsis.
+type entity struct {
+	id       string
+	docType  string
+	content  string
+	startPos int64
+	endPos   int64
+}
+
+func (e entity) Proto(score float64) *api.EntityResult {
+	return &api.EntityResult{
+		DocType:  e.docType,
+		Content:  e.content,
+		StartPos: int32(e.startPos),
+		EndPos:   int32(e.endPos),
+		Score:    float32(score),
+	}
+}
+
+func entityID(epid string, docType string, idx int) string {
+	return fmt.Sprintf("%s-%s-%d", epid, docType, idx)
+}
+
+// transcriptEntities returns all the synopses, trivia, tags and songs in the transcript.
+func transcriptEntities(ep *models.Transcript) []entity {
+	entities := []entity{}
+	for k, v := range ep.Synopsis {
+		entities = append(entities, entity{
+			id:       entityID(ep.ID(), search.DocTypeSynopsis, k),
+			docType:  search.DocTypeSynopsis,
+			content:  v.Description,
+			startPos: v.StartPos,
+			endPos:   v.EndPos,
+		})
+	}
+	for k, v := range ep.Trivia {
+		entities = append(entities, entity{
+			id:       entityID(ep.ID(), search.DocTypeTrivia, k),
+			docType:  search.DocTypeTrivia,
+			content:  v.Description,
+			startPos: v.StartPos,
+			endPos:   v.EndPos,
+		})
+	}
+	for k, v := range ep.Tags {
+		pos := tagPosition(ep, v)
+		entities = append(entities, entity{
+			id:       entityID(ep.ID(), search.DocTypeTag, k),
+			docType:  search.DocTypeTag,
+			content:  v.Name,
+			startPos: pos,
+			endPos:   pos,
+		})
+	}
+	for _, v := range ep.Transcript {
+		if v.Type != models.DialogTypeSong {
+			continue
+		}
+		// the content of song lines is already indexed as dialog, so only the metadata is of interest.
+		songParts := []string{}
+		for _, metaType := range []models.MetadataType{models.MetadataSongArtist, models.MetadataSongTrack, models.MetadataSongAlbum} {
+			if val := strings.TrimSpace(v.Meta[metaType]); val != "" {
+				songParts = append(songParts, val)
+			}
+		}
+		if len(songParts) == 0 {
+			continue
+		}
+		entities = append(entities, entity{
+			id:       entityID(ep.ID(), search.DocTypeSong, int(v.Position)),
+			docType:  search.DocTypeSong,
+			content:  strings.Join(songParts, " - "),
+			startPos: v.Position,
+			endPos:   v.Position,
+		})
+	}
+	return entities
+}
+
+// tagPosition returns the position of the first line at or after the tag's timestamp.
+func tagPosition(ep *models.Transcript, tag models.Tag) int64 {
+	for _, d := range ep.Transcript {
+		if d.Timestamp >= tag.Timestamp {
+			return d.Position
+		}
+	}
+	if len(ep.Transcript) > 0 {
+		return ep.Transcript[len(ep.Transcript)-1].Position
+	}
+	return 0
+}
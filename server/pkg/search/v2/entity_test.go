@@ -0,0 +1,41 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/pkg/models"
+	"github.com/warmans/rsk-search/pkg/search"
+)
+
+func TestTranscriptEntities(t *testing.T) {
+	ep := &models.Transcript{
+		Publication: "xfm",
+		Series:      1,
+		Episode:     1,
+		Transcript: []models.Dialog{
+			{Position: 1, Timestamp: 0, Type: models.DialogTypeChat, Content: "foo"},
+			{Position: 2, Timestamp: time.Minute, Type: models.DialogTypeSong, Meta: models.Metadata{
+				models.MetadataSongArtist: "The Beatles",
+				models.MetadataSongTrack:  "Help",
+			}},
+			{Position: 3, Timestamp: time.Minute * 2, Type: models.DialogTypeSong, Content: "untagged song"},
+			{Position: 4, Timestamp: time.Minute * 3, Type: models.DialogTypeChat, Content: "bar"},
+		},
+		Synopsis: []models.Synopsis{{Description: "Rockstar Hotel", StartPos: 1, EndPos: 4}},
+		Trivia:   []models.Trivia{{Description: "Some trivia", StartPos: 4, EndPos: 4}},
+		Tags: models.Tags{
+			{Name: "monkey news", Timestamp: time.Second * 90},
+			{Name: "after the end", Timestamp: time.Hour},
+		},
+	}
+
+	require.EqualValues(t, []entity{
+		{id: "ep-xfm-S1E01-synopsis-0", docType: search.DocTypeSynopsis, content: "Rockstar Hotel", startPos: 1, endPos: 4},
+		{id: "ep-xfm-S1E01-trivia-0", docType: search.DocTypeTrivia, content: "Some trivia", startPos: 4, endPos: 4},
+		{id: "ep-xfm-S1E01-tag-0", docType: search.DocTypeTag, content: "monkey news", startPos: 3, endPos: 3},
+		{id: "ep-xfm-S1E01-tag-1", docType: search.DocTypeTag, content: "after the end", startPos: 4, endPos: 4},
+		{id: "ep-xfm-S1E01-song-2", docType: search.DocTypeSong, content: "The Beatles - Help", startPos: 2, endPos: 2},
+	}, transcriptEntities(ep))
+}
@@ -0,0 +1,98 @@
+package v2
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/blugelabs/bluge"
+	search2 "github.com/blugelabs/bluge/search"
+	"github.com/blugelabs/bluge/search/aggregations"
+	"github.com/warmans/rsk-search/gen/api"
+)
+
+const MaxFacetValues = 50
+
+func facetAggregationName(name string) string {
+	return fmt.Sprintf("facet_%s", name)
+}
+
+func facetAggregation(name string) (search2.Aggregation, error) {
+	switch name {
+	case "publication", "type", "special":
+		return aggregations.NewTermsAggregation(search2.Field(name), MaxFacetValues), nil
+	case "series":
+		return aggregations.NewTermsAggregation(numericTermsSource{field: search2.Field(name)}, MaxFacetValues), nil
+	case "year":
+		return aggregations.NewTermsAggregation(yearTermsSource{field: search2.Field("date")}, MaxFacetValues), nil
+	}
+	return nil, fmt.Errorf("unknown facet: %s", name)
+}
+
+// addFacetAggregations adds an aggregation for each of the named facets. Facets that are named more than once are
+// only added once. The names of the added facets are returned.
+func addFacetAggregations(req *bluge.TopNSearch, names []string) ([]string, error) {
+	added := []string{}
+	for _, name := range names {
+		if slices.Contains(added, name) {
+			continue
+		}
+		agg, err := facetAggregation(name)
+		if err != nil {
+			return nil, err
+		}
+		req.AddAggregation(facetAggregationName(name), agg)
+		added = append(added, name)
+	}
+	return added, nil
+}
+
+func facetsFromAggregations(names []string, aggs *search2.Bucket) []*api.Facet {
+	facets := []*api.Facet{}
+	for _, name := range names {
+		facets = append(facets, facetFromAggregation(name, aggs.Aggregation(facetAggregationName(name)).(search2.BucketCalculator)))
+	}
+	return facets
+}
+
+func facetFromAggregation(name string, calc search2.BucketCalculator) *api.Facet {
+	facet := &api.Facet{Name: name, Values: []*api.FieldValue{}}
+	for _, b := range calc.Buckets() {
+		facet.Values = append(facet.Values, &api.FieldValue{Value: b.Name(), Count: int32(b.Count())})
+	}
+	return facet
+}
+
+// numericTermsSource allows a numeric field to be used in a terms aggregation.
+type numericTermsSource struct {
+	field search2.FieldSource
+}
+
+func (n numericTermsSource) Fields() []string {
+	return n.field.Fields()
+}
+
+func (n numericTermsSource) Values(match *search2.DocumentMatch) [][]byte {
+	var values [][]byte
+	for _, v := range n.field.Numbers(match) {
+		values = append(values, []byte(strconv.FormatFloat(v, 'f', -1, 64)))
+	}
+	return values
+}
+
+// yearTermsSource buckets a date field by year.
+type yearTermsSource struct {
+	field search2.FieldSource
+}
+
+func (y yearTermsSource) Fields() []string {
+	return y.field.Fields()
+}
+
+func (y yearTermsSource) Values(match *search2.DocumentMatch) [][]byte {
+	var values [][]byte
+	for _, v := range y.field.Dates(match) {
+		values = append(values, []byte(strconv.Itoa(v.UTC().Year())))
+	}
+	return values
+}
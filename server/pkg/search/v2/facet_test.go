@@ -0,0 +1,91 @@
+package v2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blugelabs/bluge"
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/models"
+	"github.com/warmans/rsk-search/pkg/models/modelstest"
+	"github.com/warmans/rsk-search/pkg/util"
+)
+
+func TestFacets(t *testing.T) {
+	writer, err := bluge.OpenWriter(bluge.InMemoryOnlyConfig())
+	require.NoError(t, err)
+	defer writer.Close()
+
+	ctx := context.Background()
+	indexer := NewIndexer(writer)
+
+	ep1 := modelstest.Transcript(1, [2]string{"karl", "foo"}, [2]string{"karl", "bar"})
+	ep1.ReleaseDate = util.ToPtr(time.Date(2001, 11, 10, 0, 0, 0, 0, time.UTC))
+	ep1.Transcript[1].Type = models.DialogTypeSong
+	require.NoError(t, indexer.IndexTranscript(ctx, ep1))
+
+	ep2 := modelstest.Transcript(1, [2]string{"karl", "baz"})
+	ep2.Publication, ep2.Series, ep2.Special = "preview", 2, true
+	ep2.ReleaseDate = util.ToPtr(time.Date(2002, 1, 5, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, indexer.IndexTranscript(ctx, ep2))
+
+	reader, err := writer.Reader()
+	require.NoError(t, err)
+	defer reader.Close()
+
+	tests := []struct {
+		name   string
+		facets []string
+		expect []*api.Facet
+	}{
+		{
+			name:   "publication",
+			facets: []string{"publication"},
+			expect: []*api.Facet{{Name: "publication", Values: []*api.FieldValue{{Value: "xfm", Count: 2}, {Value: "preview", Count: 1}}}},
+		},
+		{
+			name:   "series",
+			facets: []string{"series"},
+			expect: []*api.Facet{{Name: "series", Values: []*api.FieldValue{{Value: "1", Count: 2}, {Value: "2", Count: 1}}}},
+		},
+		{
+			name:   "year",
+			facets: []string{"year"},
+			expect: []*api.Facet{{Name: "year", Values: []*api.FieldValue{{Value: "2001", Count: 2}, {Value: "2002", Count: 1}}}},
+		},
+		{
+			name:   "type",
+			facets: []string{"type"},
+			expect: []*api.Facet{{Name: "type", Values: []*api.FieldValue{{Value: "chat", Count: 2}, {Value: "song", Count: 1}}}},
+		},
+		{
+			name:   "special",
+			facets: []string{"special"},
+			expect: []*api.Facet{{Name: "special", Values: []*api.FieldValue{{Value: "false", Count: 2}, {Value: "true", Count: 1}}}},
+		},
+		{
+			name:   "duplicate facets are returned once",
+			facets: []string{"special", "publication", "special"},
+			expect: []*api.Facet{
+				{Name: "special", Values: []*api.FieldValue{{Value: "false", Count: 2}, {Value: "true", Count: 1}}},
+				{Name: "publication", Values: []*api.FieldValue{{Value: "xfm", Count: 2}, {Value: "preview", Count: 1}}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := bluge.NewTopNSearch(0, bluge.NewMatchAllQuery())
+			facets, err := addFacetAggregations(req, tt.facets)
+			require.NoError(t, err)
+
+			dmi, err := reader.Search(ctx, req)
+			require.NoError(t, err)
+			require.EqualValues(t, tt.expect, facetsFromAggregations(facets, dmi.Aggregations()))
+		})
+	}
+
+	_, err = addFacetAggregations(bluge.NewTopNSearch(0, bluge.NewMatchAllQuery()), []string{"actor"})
+	require.Error(t, err)
+}
@@ -0,0 +1,266 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/blugelabs/bluge"
+	"github.com/blugelabs/bluge/analysis"
+	"github.com/blugelabs/bluge/analysis/token"
+	"github.com/blugelabs/bluge/analysis/tokenizer"
+	"github.com/blugelabs/bluge/index"
+	search2 "github.com/blugelabs/bluge/search"
+	"github.com/blugelabs/bluge/search/aggregations"
+	"github.com/pkg/errors"
+	"github.com/warmans/rsk-search/pkg/models"
+	"github.com/warmans/rsk-search/pkg/phonetic"
+	"github.com/warmans/rsk-search/pkg/search"
+	"github.com/warmans/rsk-search/pkg/search/v2/mapping"
+)
+
+// MaxIndexedTranscripts limits the number of transcripts that can be tracked when finding the indexed versions.
+const MaxIndexedTranscripts = 10000
+
+func NewIndexer(writer *bluge.Writer) *Indexer {
+	return &Indexer{writer: writer}
+}
+
+// Indexer maintains the search index one transcript at a time so that changed transcripts can be
+// re-indexed without rebuilding the whole index.
+type Indexer struct {
+	writer *bluge.Writer
+}
+
+// IndexTranscript replaces all documents belonging to the transcript in a single batch.
+func (i *Indexer) IndexTranscript(ctx context.Context, ep *models.Transcript) error {
+	batch := bluge.NewBatch()
+	if err := i.deleteTranscriptDocuments(ctx, ep.ID(), batch); err != nil {
+		return err
+	}
+	for _, d := range DocumentsFromTranscript(ep) {
+		batch.Insert(BlugeDocument(d))
+	}
+	if err := i.writer.Batch(batch); err != nil {
+		return errors.Wrapf(err, "failed to index transcript %s", ep.ID())
+	}
+	return nil
+}
+
+// RemoveTranscript deletes all documents belonging to the transcript.
+func (i *Indexer) RemoveTranscript(ctx context.Context, transcriptID string) error {
+	batch := bluge.NewBatch()
+	if err := i.deleteTranscriptDocuments(ctx, transcriptID, batch); err != nil {
+		return err
+	}
+	if err := i.writer.Batch(batch); err != nil {
+		return errors.Wrapf(err, "failed to remove transcript %s", transcriptID)
+	}
+	return nil
+}
+
+// IndexedVersions returns the version of each transcript currently in the index, keyed by transcript ID.
+func (i *Indexer) IndexedVersions(ctx context.Context) (map[string]string, error) {
+	reader, err := i.writer.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	agg := aggregations.NewTermsAggregation(search2.Field("transcript_id"), MaxIndexedTranscripts)
+	agg.AddAggregation("transcript_version", aggregations.NewTermsAggregation(search2.Field("transcript_version"), 1))
+
+	req := bluge.NewTopNSearch(0, bluge.NewMatchAllQuery())
+	req.AddAggregation("transcripts", agg)
+
+	dmi, err := reader.Search(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	// the iterator must be consumed before the aggregations are available
+	next, err := dmi.Next()
+	for err == nil && next != nil {
+		next, err = dmi.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	versions := map[string]string{}
+	for _, transcript := range dmi.Aggregations().Buckets("transcripts") {
+		versions[transcript.Name()] = ""
+		if buckets := transcript.Buckets("transcript_version"); len(buckets) > 0 {
+			versions[transcript.Name()] = buckets[0].Name()
+		}
+	}
+	return versions, nil
+}
+
+// Stale compares the given transcripts to the index and returns the transcripts that need to be (re)indexed
+// and the IDs of any indexed transcripts that no longer exist.
+func (i *Indexer) Stale(ctx context.Context, episodes []*models.Transcript) ([]*models.Transcript, []string, error) {
+	indexed, err := i.IndexedVersions(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	stale, removed := staleTranscripts(indexed, episodes)
+	return stale, removed, nil
+}
+
+func staleTranscripts(indexed map[string]string, episodes []*models.Transcript) ([]*models.Transcript, []string) {
+	stale := []*models.Transcript{}
+	current := map[string]struct{}{}
+	for _, ep := range episodes {
+		// transcripts with no dialog have no documents so should be removed if they were previously indexed.
+		if len(ep.Transcript) == 0 {
+			continue
+		}
+		current[ep.ID()] = struct{}{}
+		if version, ok := indexed[ep.ID()]; !ok || version != ep.Version {
+			stale = append(stale, ep)
+		}
+	}
+	removed := []string{}
+	for id := range indexed {
+		if _, ok := current[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	sort.Strings(removed)
+	return stale, removed
+}
+
+func (i *Indexer) deleteTranscriptDocuments(ctx context.Context, transcriptID string, batch *index.Batch) error {
+	reader, err := i.writer.Reader()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	dmi, err := reader.Search(ctx, bluge.NewAllMatches(bluge.NewTermQuery(transcriptID).SetField("transcript_id")))
+	if err != nil {
+		return err
+	}
+	next, err := dmi.Next()
+	for err == nil && next != nil {
+		err = next.VisitStoredFields(func(field string, value []byte) bool {
+			if field == "_id" {
+				batch.Delete(bluge.Identifier(value))
+				return false
+			}
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("error accessing stored fields: %v", err)
+		}
+		next, err = dmi.Next()
+	}
+	return err
+}
+
+// DocumentsFromTranscript creates a search document for each line of dialog in the transcript and for
+// each entity (synopsis, trivia etc.) that refers to a range of dialog.
+func DocumentsFromTranscript(episode *models.Transcript) []search.DialogDocument {
+	docs := []search.DialogDocument{}
+	for _, v := range episode.Transcript {
+		docs = append(docs, search.DialogDocument{
+			ID:                v.ID,
+			TranscriptID:      episode.ID(),
+			TranscriptVersion: episode.Version,
+			Mapping:           "dialog",
+			DocType:           search.DocTypeDialog,
+			Publication:       episode.Publication,
+			Series:            int64(episode.Series),
+			Episode:           int64(episode.Episode),
+			Date:              episode.ReleaseDate,
+			ContentType:       string(v.Type),
+			Actor:             v.Actor,
+			Position:          v.Position,
+			EndPosition:       v.Position,
+			Offset:            v.Timestamp,
+			OffsetInferred:    v.TimestampInferred,
+			Content:           v.Content,
+			Special:           fmt.Sprintf("%v", episode.Special),
+		})
+	}
+	// entities cannot exist without dialog so if there is no dialog the transcript is not indexed at all.
+	if len(episode.Transcript) == 0 {
+		return docs
+	}
+	dialogByPos := map[int64]models.Dialog{}
+	for _, v := range episode.Transcript {
+		dialogByPos[v.Position] = v
+	}
+	for _, v := range transcriptEntities(episode) {
+		// entities take the offset of the first line they refer to.
+		startLine := dialogByPos[v.startPos]
+		docs = append(docs, search.DialogDocument{
+			ID:                v.id,
+			TranscriptID:      episode.ID(),
+			TranscriptVersion: episode.Version,
+			Mapping:           v.docType,
+			DocType:           v.docType,
+			Publication:       episode.Publication,
+			Series:            int64(episode.Series),
+			Episode:           int64(episode.Episode),
+			Date:              episode.ReleaseDate,
+			Position:          v.startPos,
+			EndPosition:       v.endPos,
+			Offset:            startLine.Timestamp,
+			OffsetInferred:    startLine.TimestampInferred,
+			Content:           v.content,
+			Special:           fmt.Sprintf("%v", episode.Special),
+		})
+	}
+	return docs
+}
+
+// BlugeDocument maps the dialog document to index fields.
+func BlugeDocument(d search.DialogDocument) *bluge.Document {
+	doc := bluge.NewDocument(d.ID)
+	for k, t := range mapping.Mapping {
+		// entities have no actor or dialog type so empty keywords are omitted to keep them out of aggregations.
+		if d.DocType != search.DocTypeDialog && t == mapping.FieldTypeKeyword && d.GetNamedField(k) == "" {
+			continue
+		}
+		if mapped, ok := getMappedField(k, t, d); ok {
+			doc.AddField(mapped)
+		}
+	}
+	return doc
+}
+
+func getMappedField(fieldName string, t mapping.FieldType, d search.DialogDocument) (bluge.Field, bool) {
+	switch t {
+	case mapping.FieldTypeKeyword:
+		return bluge.NewKeywordField(fieldName, d.GetNamedField(fieldName).(string)).StoreValue().Aggregatable(), true
+	case mapping.FieldTypeDate:
+		dateField := d.GetNamedField(fieldName).(*time.Time)
+		if dateField == nil {
+			return nil, false
+		}
+		return bluge.NewDateTimeField(fieldName, *dateField).Aggregatable().Sortable(), true
+	case mapping.FieldTypeNumber:
+		switch v := d.GetNamedField(fieldName).(type) {
+		case float64:
+			return bluge.NewNumericField(fieldName, v).Sortable(), true
+		default:
+			return bluge.NewNumericField(fieldName, float64(v.(int64))).Sortable(), true
+		}
+	case mapping.FieldTypePhonetic:
+		// the original text is already stored in the content field.
+		return bluge.NewTextField(fieldName, fmt.Sprintf("%v", d.GetNamedField(fieldName))).WithAnalyzer(phonetic.Analyzer).SearchTermPositions(), true
+	case mapping.FieldTypeShingles:
+		shingleAnalyzer := &analysis.Analyzer{
+			Tokenizer: tokenizer.NewUnicodeTokenizer(),
+			TokenFilters: []analysis.TokenFilter{
+				//token.NewLowerCaseFilter(),
+				token.NewNgramFilter(2, 16),
+			},
+		}
+		return bluge.NewTextField(fieldName, fmt.Sprintf("%v", d.GetNamedField(fieldName))).WithAnalyzer(shingleAnalyzer).SearchTermPositions().StoreValue(), true
+	}
+	// just use text for everything else
+	return bluge.NewTextField(fieldName, fmt.Sprintf("%v", d.GetNamedField(fieldName))).SearchTermPositions().StoreValue(), true
+}
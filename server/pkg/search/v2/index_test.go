@@ -0,0 +1,54 @@
+package v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blugelabs/bluge"
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/pkg/models"
+	"github.com/warmans/rsk-search/pkg/models/modelstest"
+)
+
+func TestIndexer(t *testing.T) {
+	writer, err := bluge.OpenWriter(bluge.InMemoryOnlyConfig())
+	require.NoError(t, err)
+	defer writer.Close()
+
+	ctx := context.Background()
+	indexer := NewIndexer(writer)
+
+	ep1 := modelstest.Transcript(1, [2]string{"karl", "foo"}, [2]string{"karl", "bar"}, [2]string{"karl", "baz"})
+	ep1.Version = "0.0.1"
+	ep2 := modelstest.Transcript(2, [2]string{"karl", "foo"})
+	ep2.Version = "0.0.1"
+	require.NoError(t, indexer.IndexTranscript(ctx, ep1))
+	require.NoError(t, indexer.IndexTranscript(ctx, ep2))
+
+	versions, err := indexer.IndexedVersions(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]string{ep1.ID(): "0.0.1", ep2.ID(): "0.0.1"}, versions)
+
+	// ep1 is shortened and ep2 no longer exists
+	ep1 = modelstest.Transcript(1, [2]string{"karl", "foo"})
+	ep1.Version = "0.1.0"
+	stale, removed, err := indexer.Stale(ctx, []*models.Transcript{ep1})
+	require.NoError(t, err)
+	require.Len(t, stale, 1)
+	require.Equal(t, ep1.ID(), stale[0].ID())
+	require.EqualValues(t, []string{ep2.ID()}, removed)
+
+	require.NoError(t, indexer.IndexTranscript(ctx, ep1))
+	require.NoError(t, indexer.RemoveTranscript(ctx, ep2.ID()))
+
+	versions, err = indexer.IndexedVersions(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]string{ep1.ID(): "0.1.0"}, versions)
+
+	reader, err := writer.Reader()
+	require.NoError(t, err)
+	defer reader.Close()
+	count, err := reader.Count()
+	require.NoError(t, err)
+	require.EqualValues(t, 1, count)
+}
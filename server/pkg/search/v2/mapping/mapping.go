@@ -8,17 +8,30 @@ const (
 	FieldTypeNumber   FieldType = "number"
 	FieldTypeDate     FieldType = "date"
 	FieldTypeShingles FieldType = "shingles"
+	FieldTypePhonetic FieldType = "phonetic"
 )
 
+// PhoneticField returns the name of the field containing the phonetic codes for the given text field.
+func PhoneticField(field string) (string, bool) {
+	name := field + "_phonetic"
+	return name, Mapping[name] == FieldTypePhonetic
+}
+
 var Mapping = map[string]FieldType{
-	"transcript_id": FieldTypeKeyword,
-	"publication":   FieldTypeKeyword,
-	"series":        FieldTypeNumber,
-	"episode":       FieldTypeNumber,
-	"date":          FieldTypeDate,
-	"actor":         FieldTypeKeyword,
-	"pos":           FieldTypeNumber,
-	"content":       FieldTypeText,
-	"type":          FieldTypeKeyword,
-	"special":       FieldTypeKeyword, // true or false
+	"transcript_id":      FieldTypeKeyword,
+	"transcript_version": FieldTypeKeyword,
+	"publication":        FieldTypeKeyword,
+	"series":             FieldTypeNumber,
+	"episode":            FieldTypeNumber,
+	"date":               FieldTypeDate,
+	"actor":              FieldTypeKeyword,
+	"pos":                FieldTypeNumber,
+	"end_pos":            FieldTypeNumber,
+	"offset":             FieldTypeNumber, // seconds
+	"offset_inferred":    FieldTypeNumber, // 1 if the offset is an estimate
+	"doc_type":           FieldTypeKeyword,
+	"content":            FieldTypeText,
+	"content_phonetic":   FieldTypePhonetic, // content indexed by sound
+	"type":               FieldTypeKeyword,
+	"special":            FieldTypeKeyword, // true or false
 }
@@ -0,0 +1,43 @@
+package v2
+
+import (
+	"sync"
+
+	"github.com/blugelabs/bluge"
+)
+
+// indexReader tracks the searches using a reader so it can be closed once they are complete.
+type indexReader struct {
+	*bluge.Reader
+	inFlight sync.WaitGroup
+}
+
+func (r *indexReader) release() {
+	r.inFlight.Done()
+}
+
+// acquireIndex must be paired with a call to release once the search has completed.
+func (s *Search) acquireIndex() *indexReader {
+	s.indexLock.RLock()
+	defer s.indexLock.RUnlock()
+	s.index.inFlight.Add(1)
+	return s.index
+}
+
+// SwapIndex replaces the index used for new searches. It blocks until searches still using the previous
+// index are completed then closes it.
+func (s *Search) SwapIndex(reader *bluge.Reader) error {
+	s.indexLock.Lock()
+	old := s.index
+	s.index = &indexReader{Reader: reader}
+	s.generation.Add(1)
+	s.indexLock.Unlock()
+
+	old.inFlight.Wait()
+	return old.Close()
+}
+
+// IndexGeneration is incremented each time the index is swapped so results from a previous index can be discarded.
+func (s *Search) IndexGeneration() uint64 {
+	return s.generation.Load()
+}
@@ -0,0 +1,47 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/blugelabs/bluge"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func testReader(t *testing.T) *bluge.Reader {
+	writer, err := bluge.OpenWriter(bluge.InMemoryOnlyConfig())
+	require.NoError(t, err)
+	defer writer.Close()
+	reader, err := writer.Reader()
+	require.NoError(t, err)
+	return reader
+}
+
+func TestSwapIndexWaitsForInFlightSearches(t *testing.T) {
+	s := NewSearch(testReader(t), nil, "", zap.NewNop())
+
+	oldIndex := s.acquireIndex()
+
+	swapped := make(chan error)
+	newReader := testReader(t)
+	go func() {
+		swapped <- s.SwapIndex(newReader)
+	}()
+
+	// new searches must use the new index while the old one is still in use.
+	require.Eventually(t, func() bool {
+		index := s.acquireIndex()
+		defer index.release()
+		return index.Reader == newReader
+	}, time.Second, time.Millisecond)
+
+	select {
+	case <-swapped:
+		t.Fatal("swap completed before in-flight search was released")
+	default:
+	}
+
+	oldIndex.release()
+	require.NoError(t, <-swapped)
+}
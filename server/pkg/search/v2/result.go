@@ -0,0 +1,54 @@
+package v2
+
+import (
+	"fmt"
+
+	search2 "github.com/blugelabs/bluge/search"
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/models"
+	"github.com/warmans/rsk-search/pkg/search"
+)
+
+func newHit(match *search2.DocumentMatch) (search.Hit, error) {
+	h := search.Hit{Score: match.Score, Explanation: scoreExplanation(match.Explanation)}
+	err := match.VisitStoredFields(func(field string, value []byte) bool {
+		switch field {
+		case "_id":
+			h.ID = string(value)
+		case "transcript_id":
+			h.TranscriptID = string(value)
+		case "doc_type":
+			h.DocType = string(value)
+		}
+		return true
+	})
+	if err != nil {
+		return search.Hit{}, fmt.Errorf("error accessing stored fields: %v", err)
+	}
+	return h, nil
+}
+
+// entityResult returns the matched synopsis, trivia etc. or nil if it no longer exists.
+func entityResult(ep *models.Transcript, entityID string, score float64) *api.SearchResult {
+	for _, e := range transcriptEntities(ep) {
+		if e.id == entityID {
+			return &api.SearchResult{
+				Episode: ep.ShortProto(false),
+				Dialogs: []*api.DialogResult{},
+				Entity:  e.Proto(score),
+			}
+		}
+	}
+	return nil
+}
+
+func scoreExplanation(e *search2.Explanation) *api.ScoreExplanation {
+	if e == nil {
+		return nil
+	}
+	out := &api.ScoreExplanation{Value: float32(e.Value), Message: e.Message}
+	for _, child := range e.Children {
+		out.Children = append(out.Children, scoreExplanation(child))
+	}
+	return out
+}
@@ -15,28 +15,26 @@ import (
 	"github.com/warmans/rsk-search/pkg/meta"
 	"github.com/warmans/rsk-search/pkg/models"
 	"github.com/warmans/rsk-search/pkg/search"
-	"github.com/warmans/rsk-search/pkg/store/ro"
 	"go.uber.org/zap"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 )
 
 const (
-	ResultContextLines = 3
-	PageSize           = 10
+	PageSize = 10
 )
 
 func NewSearch(
 	index *bluge.Reader,
-	readOnlyDB *ro.Conn,
 	episodeCache *data.EpisodeCache,
 	audioUriPattern string,
 	logger *zap.Logger,
-) search.Searcher {
+) *Search {
 	return &Search{
-		index:           index,
-		readOnlyDB:      readOnlyDB,
+		index:           &indexReader{Reader: index},
 		episodeCache:    episodeCache,
 		logger:          logger,
 		audioUriPattern: audioUriPattern,
@@ -44,30 +42,61 @@ func NewSearch(
 }
 
 type Search struct {
-	index           *bluge.Reader
-	readOnlyDB      *ro.Conn
+	index           *indexReader
+	indexLock       sync.RWMutex
+	generation      atomic.Uint64
 	episodeCache    *data.EpisodeCache
 	logger          *zap.Logger
 	audioUriPattern string
 }
 
-func (s *Search) Search(ctx context.Context, f filter.Filter, page int32, sortBy string) (*api.SearchResultList, error) {
+func (s *Search) Search(ctx context.Context, searchReq search.Request) (*api.SearchResultList, error) {
 
-	query, err := bluge_query.FilterToQuery(f)
+	queryOpts := []bluge_query.QueryOption{}
+	if !searchReq.DisableAliases {
+		queryOpts = append(queryOpts, bluge_query.WithAliasExpansion())
+	}
+	query, err := bluge_query.FilterToQuery(searchReq.Filter, queryOpts...)
 	if err != nil {
 		return nil, err
 	}
 
+	pageSize := PageSize
+	if searchReq.PageSize > 0 {
+		pageSize = int(searchReq.PageSize)
+	}
+	sortBy := searchReq.Sort
+	if sortBy == "" {
+		sortBy = "_score"
+	}
+
 	agg := aggregations.NewTermsAggregation(search2.Field("actor"), 25)
 	agg.AddAggregation("transcript_id", aggregations.NewTermsAggregation(search2.Field("transcript_id"), 150))
 
-	req := bluge.NewTopNSearch(PageSize, query).SetFrom(PageSize * int(page)).WithStandardAggregations()
+	// the ID is included in the sort to give a stable order for documents with the same sort value.
+	req := bluge.NewTopNSearch(pageSize, query).SortBy([]string{sortBy, "_id"}).WithStandardAggregations()
+	if searchReq.SearchAfter != "" {
+		after, err := decodeCursor(searchReq.SearchAfter, sortBy)
+		if err != nil {
+			return nil, err
+		}
+		req.After(after)
+	} else {
+		req.SetFrom(pageSize * int(searchReq.Page))
+	}
+	if searchReq.Explain {
+		req.ExplainScores()
+	}
 	req.AddAggregation("actor_count_over_time", agg)
-	if sortBy != "" {
-		req = req.SortBy([]string{sortBy})
+	facets, err := addFacetAggregations(req, searchReq.Facets)
+	if err != nil {
+		return nil, err
 	}
 
-	dmi, err := s.index.Search(ctx, req)
+	index := s.acquireIndex()
+	defer index.release()
+
+	dmi, err := index.Search(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +104,16 @@ func (s *Search) Search(ctx context.Context, f filter.Filter, page int32, sortBy
 		ResultCount: int32(dmi.Aggregations().Count()),
 		Results:     []*api.SearchResult{},
 		Stats:       map[string]*api.SearchStats{},
+		Facets:      []*api.Facet{},
 	}
+	if searchReq.Explain {
+		res.Explanation = &api.SearchExplanation{
+			Filter: filter.MustPrint(searchReq.Filter),
+			Query:  bluge_query.DescribeQuery(query),
+		}
+	}
+
+	res.Facets = facetsFromAggregations(facets, dmi.Aggregations())
 
 	for _, actorBucket := range dmi.Aggregations().Aggregation("actor_count_over_time").(search2.BucketCalculator).Buckets() {
 		res.Stats[actorBucket.Name()] = &api.SearchStats{
@@ -100,61 +138,18 @@ func (s *Search) Search(ctx context.Context, f filter.Filter, page int32, sortBy
 		}
 	}
 
-	res.Results = []*api.SearchResult{}
+	var lastSortValue [][]byte
+	hits := []search.Hit{}
 
 	next, err := dmi.Next()
 	for err == nil && next != nil {
-		result := &api.SearchResult{
-			Dialogs: []*api.DialogResult{},
-		}
-		err := s.readOnlyDB.WithStore(func(store *ro.Store) error {
+		lastSortValue = next.SortValue
 
-			var innerErr error
-			err = next.VisitStoredFields(func(field string, value []byte) bool {
-				if innerErr != nil {
-					return false
-				}
-				if field == "_id" {
-					dialogs, episodeID, err := store.GetDialogWithContext(ctx, string(value), ResultContextLines)
-					if err != nil {
-						innerErr = err
-						return false
-					}
-					// it's possible the episode won't be found, if so skip this result
-					if episodeID == "" {
-						s.logger.Warn("failed to find dialog line, is the DB out of sync with the index?", zap.String("dialog_id", string(value)))
-						return true
-					}
-					ep, err := s.episodeCache.GetEpisode(episodeID, false)
-					if err != nil {
-						innerErr = errors.Wrapf(err, "episode ID: %s", episodeID)
-						return false
-					}
-					result.Episode = ep.ShortProto(false)
-
-					// dialogs
-					lines := make([]*api.Dialog, len(dialogs))
-					for k, d := range dialogs {
-						lines[k] = d.Proto(string(value) == d.ID)
-					}
-					result.Dialogs = append(result.Dialogs, &api.DialogResult{Transcript: lines, Score: float32(next.Score)})
-				}
-				return true
-			})
-			if err != nil {
-				return fmt.Errorf("error accessing stored fields: %v", err)
-			}
-			if innerErr != nil {
-				return fmt.Errorf("error processing result: %v", innerErr)
-			}
-			return nil
-		})
-		if err != nil {
+		var h search.Hit
+		if h, err = newHit(next); err != nil {
 			return nil, err
 		}
-		if result.Episode != nil {
-			res.Results = append(res.Results, result)
-		}
+		hits = append(hits, h)
 
 		if next, err = dmi.Next(); err != nil {
 			return nil, err
@@ -164,6 +159,27 @@ func (s *Search) Search(ctx context.Context, f filter.Filter, page int32, sortBy
 		return nil, err
 	}
 
+	contextLines := search.DefaultContextLines
+	if searchReq.ContextLines > 0 {
+		contextLines = int(searchReq.ContextLines)
+	}
+	if res.Results, err = search.HitResults(s.episodeCache, s.logger, hits, contextLines, entityResult); err != nil {
+		return nil, err
+	}
+
+	if res.ResultCount == 0 {
+		if res.Suggestions, err = suggestQueries(ctx, index, searchReq.Filter); err != nil {
+			return nil, errors.Wrap(err, "failed to create suggestions")
+		}
+	}
+
+	// if the page was not full there cannot be any more results.
+	if len(hits) == pageSize && lastSortValue != nil {
+		if res.NextSearchAfter, err = encodeCursor(sortBy, lastSortValue); err != nil {
+			return nil, err
+		}
+	}
+
 	return res, nil
 }
 
@@ -171,7 +187,10 @@ func (s *Search) ListTerms(fieldName string, prefix string) (models.FieldValues,
 
 	terms := models.FieldValues{}
 
-	fieldDict, err := s.index.DictionaryIterator(fieldName, nil, []byte(prefix), nil)
+	index := s.acquireIndex()
+	defer index.release()
+
+	fieldDict, err := index.DictionaryIterator(fieldName, nil, []byte(prefix), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -241,7 +260,7 @@ func (s *Search) PredictSearchTerms(ctx context.Context, prefix string, exact bo
 	}
 
 	// fetch extras in case some need to be discarded
-	req := bluge.NewTopNSearch(maxInt(int(numPredictions), 50), q).SetFrom(0)
+	req := bluge.NewTopNSearch(maxInt(int(numPredictions), 50), dialogOnly(q)).SetFrom(0)
 	req.IncludeLocations()
 
 	highlighter := highlight.NewSimpleHighlighter(
@@ -250,7 +269,10 @@ func (s *Search) PredictSearchTerms(ctx context.Context, prefix string, exact bo
 		"[...]",
 	)
 
-	dmi, err := s.index.Search(ctx, req)
+	index := s.acquireIndex()
+	defer index.release()
+
+	dmi, err := index.Search(ctx, req)
 	if err != nil {
 		return nil, errors.Wrap(err, "search failed")
 	}
@@ -297,7 +319,7 @@ func (s *Search) PredictSearchTerms(ctx context.Context, prefix string, exact bo
 
 		if !duplicate && stringsAreNotTooSimilar(prefix, p.Line) {
 			// highlight and fragment result
-			fragments := highlighter.BestFragments(next.Locations["content"], []byte(p.Line), 1)
+			fragments := highlighter.BestFragments(contentLocations(next), []byte(p.Line), 1)
 			if len(fragments) > 0 {
 				p.Fragment = fragments[0]
 			}
@@ -327,6 +349,24 @@ func stringsAreNotTooSimilar(search, found string) bool {
 	return strings.Trim(strings.ToLower(search), ".?,!") != strings.Trim(strings.ToLower(found), ".?,!")
 }
 
+// contentLocations returns the locations of the terms matched in the content. Phonetic matches have the offsets of
+// the original words so are highlighted the same way.
+func contentLocations(match *search2.DocumentMatch) search2.TermLocationMap {
+	locations := search2.TermLocationMap{}
+	for term, locs := range match.Locations["content"] {
+		locations[term] = append(locations[term], locs...)
+	}
+	for term, locs := range match.Locations["content_phonetic"] {
+		locations["content_phonetic:"+term] = append(locations["content_phonetic:"+term], locs...)
+	}
+	return locations
+}
+
+// dialogOnly restricts the query to lines of dialog.
+func dialogOnly(q bluge.Query) bluge.Query {
+	return bluge.NewBooleanQuery().AddMust(q, bluge.NewTermQuery(search.DocTypeDialog).SetField("doc_type"))
+}
+
 // index id is in the format [epid]-[pos] e.g. ep-xfm-S1E06-347
 func extractEpidAndPos(indexID string) (string, int32, error) {
 	segments := strings.Split(indexID, "-")
@@ -0,0 +1,67 @@
+package v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blugelabs/bluge"
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/pkg/filter"
+	"github.com/warmans/rsk-search/pkg/filter/bluge_query"
+	"github.com/warmans/rsk-search/pkg/models/modelstest"
+	"go.uber.org/zap"
+)
+
+func TestPredictSearchTermsPhonetic(t *testing.T) {
+	writer, err := bluge.OpenWriter(bluge.InMemoryOnlyConfig())
+	require.NoError(t, err)
+	defer writer.Close()
+
+	ctx := context.Background()
+	require.NoError(t, NewIndexer(writer).IndexTranscript(ctx, modelstest.Transcript(
+		1,
+		[2]string{"karl", "have you heard of Hobberman?"},
+		[2]string{"karl", "no I have not"},
+		[2]string{"karl", "Hoberman the magician"},
+	)))
+	reader, err := writer.Reader()
+	require.NoError(t, err)
+
+	s := NewSearch(reader, nil, "", zap.NewNop())
+
+	res, err := s.PredictSearchTerms(ctx, "", false, 10, filter.MustParse(`content ~p "hoberman"`))
+	require.NoError(t, err)
+
+	fragments := []string{}
+	for _, p := range res.Predictions {
+		fragments = append(fragments, p.Fragment)
+	}
+	require.ElementsMatch(t, []string{"have you heard of {{Hobberman}}?", "{{Hoberman}} the magician"}, fragments)
+}
+
+func TestPatternFiltersIgnoreCase(t *testing.T) {
+	writer, err := bluge.OpenWriter(bluge.InMemoryOnlyConfig())
+	require.NoError(t, err)
+	defer writer.Close()
+
+	ctx := context.Background()
+	require.NoError(t, NewIndexer(writer).IndexTranscript(ctx, modelstest.Transcript(
+		1,
+		[2]string{"karl", "Karl Pilkington"},
+		[2]string{"karl", "Pilky"},
+		[2]string{"karl", "no"},
+	)))
+	reader, err := writer.Reader()
+	require.NoError(t, err)
+
+	for _, f := range []string{`content =~ "Pilk.*"`, `content =~ "pilk.*"`, `content ~* "Pilk*"`} {
+		t.Run(f, func(t *testing.T) {
+			q, err := bluge_query.FilterToQuery(filter.MustParse(f))
+			require.NoError(t, err)
+
+			dmi, err := reader.Search(ctx, bluge.NewTopNSearch(10, dialogOnly(q)).WithStandardAggregations())
+			require.NoError(t, err)
+			require.EqualValues(t, 2, dmi.Aggregations().Count())
+		})
+	}
+}
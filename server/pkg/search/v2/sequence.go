@@ -0,0 +1,275 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/blugelabs/bluge"
+	"github.com/pkg/errors"
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/data"
+	"github.com/warmans/rsk-search/pkg/filter"
+	"github.com/warmans/rsk-search/pkg/filter/bluge_query"
+	"github.com/warmans/rsk-search/pkg/search"
+	"go.uber.org/zap"
+)
+
+// MaxSequenceStepMatches limits the number of lines a single step of a sequence may match since all the
+// matches need to be held in memory to find the sequences.
+const MaxSequenceStepMatches = 50000
+
+type sequenceLine struct {
+	pos   int32
+	score float64
+}
+
+type sequenceMatch struct {
+	epid      string
+	positions []int32
+	score     float64
+}
+
+func (m sequenceMatch) start() int32 {
+	return m.positions[0]
+}
+
+func (m sequenceMatch) end() int32 {
+	return m.positions[len(m.positions)-1]
+}
+
+// SearchSequence finds exchanges where each step matches a line following the line matched by the previous
+// step, no more than window lines later. Each sequence is returned as a single result spanning all of its lines.
+func (s *Search) SearchSequence(ctx context.Context, f filter.Filter, steps []filter.Filter, window int32, page int32, pageSize int32, sortBy string, contextLines int32) (*api.SearchResultList, error) {
+	if len(steps) == 0 {
+		return nil, errors.New("sequence must have at least one step")
+	}
+	index := s.acquireIndex()
+	defer index.release()
+
+	stepLines := make([]map[string][]sequenceLine, len(steps))
+	for k, step := range steps {
+		if f != nil {
+			step = filter.And(f, step)
+		}
+		lines, err := findAllLines(ctx, index, step)
+		if err != nil {
+			return nil, errors.Wrapf(err, "step %d", k)
+		}
+		stepLines[k] = lines
+	}
+
+	matches, err := s.withEpisodes(matchSequences(stepLines, window))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.sortSequences(matches, sortBy); err != nil {
+		return nil, err
+	}
+
+	res := &api.SearchResultList{
+		ResultCount: int32(len(matches)),
+		Results:     []*api.SearchResult{},
+		Stats:       map[string]*api.SearchStats{},
+	}
+
+	size := PageSize
+	if pageSize > 0 {
+		size = int(pageSize)
+	}
+	if contextLines <= 0 {
+		contextLines = search.DefaultContextLines
+	}
+	from := min(size*int(page), len(matches))
+	to := min(from+size, len(matches))
+	for _, match := range matches[from:to] {
+		ep, err := s.episodeCache.GetEpisode(match.epid, false)
+		if err != nil {
+			return nil, errors.Wrapf(err, "episode ID: %s", match.epid)
+		}
+		matched := map[int64]struct{}{}
+		for _, pos := range match.positions {
+			matched[int64(pos)] = struct{}{}
+		}
+		lines := []*api.Dialog{}
+		for _, d := range ep.Transcript {
+			if d.Position < int64(match.start())-int64(contextLines) || d.Position > int64(match.end())+int64(contextLines) {
+				continue
+			}
+			_, isMatched := matched[d.Position]
+			lines = append(lines, d.Proto(isMatched))
+		}
+		res.Results = append(res.Results, &api.SearchResult{
+			Episode: ep.ShortProto(false),
+			Dialogs: []*api.DialogResult{{Transcript: lines, Score: float32(match.score)}},
+		})
+	}
+
+	return res, nil
+}
+
+// withEpisodes drops any matches for episodes missing from the cache so they are not counted as results.
+func (s *Search) withEpisodes(matches []sequenceMatch) ([]sequenceMatch, error) {
+	found := []sequenceMatch{}
+	for _, match := range matches {
+		if _, err := s.episodeCache.GetEpisode(match.epid, false); err != nil {
+			if errors.Is(err, data.ErrNotFound) {
+				s.logger.Warn("failed to find sequence episode, is the cache out of sync with the index?", zap.String("episode_id", match.epid))
+				continue
+			}
+			return nil, errors.Wrapf(err, "episode ID: %s", match.epid)
+		}
+		found = append(found, match)
+	}
+	return found, nil
+}
+
+// findAllLines returns the positions of every line matching the filter, grouped by episode and ordered by position.
+func findAllLines(ctx context.Context, index *indexReader, f filter.Filter) (map[string][]sequenceLine, error) {
+	query, err := bluge_query.FilterToQuery(f)
+	if err != nil {
+		return nil, err
+	}
+	dmi, err := index.Search(ctx, bluge.NewAllMatches(dialogOnly(query)))
+	if err != nil {
+		return nil, err
+	}
+
+	lines := map[string][]sequenceLine{}
+	numMatches := 0
+
+	next, err := dmi.Next()
+	for err == nil && next != nil {
+		if numMatches++; numMatches > MaxSequenceStepMatches {
+			return nil, search.ErrSequenceTooBroad
+		}
+		var innerErr error
+		err = next.VisitStoredFields(func(field string, value []byte) bool {
+			if field != "_id" {
+				return true
+			}
+			epid, pos, err := extractEpidAndPos(string(value))
+			if err != nil {
+				innerErr = err
+				return false
+			}
+			lines[epid] = append(lines[epid], sequenceLine{pos: pos, score: next.Score})
+			return false
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error accessing stored fields: %v", err)
+		}
+		if innerErr != nil {
+			return nil, innerErr
+		}
+		next, err = dmi.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, epLines := range lines {
+		sort.Slice(epLines, func(i, j int) bool {
+			return epLines[i].pos < epLines[j].pos
+		})
+	}
+	return lines, nil
+}
+
+func (s *Search) sortSequences(matches []sequenceMatch, sortBy string) error {
+	switch sortBy {
+	case "", "_score":
+		sort.SliceStable(matches, func(i, j int) bool {
+			return matches[i].score > matches[j].score
+		})
+	case "date", "-date":
+		dates := map[string]int64{}
+		for _, m := range matches {
+			if _, ok := dates[m.epid]; ok {
+				continue
+			}
+			ep, err := s.episodeCache.GetEpisode(m.epid, false)
+			if err != nil {
+				if errors.Is(err, data.ErrNotFound) {
+					continue
+				}
+				return err
+			}
+			if ep.ReleaseDate != nil {
+				dates[m.epid] = ep.ReleaseDate.Unix()
+			}
+		}
+		sort.SliceStable(matches, func(i, j int) bool {
+			if sortBy == "-date" {
+				return dates[matches[i].epid] > dates[matches[j].epid]
+			}
+			return dates[matches[i].epid] < dates[matches[j].epid]
+		})
+	default:
+		return fmt.Errorf("unsupported sequence sort: %s", sortBy)
+	}
+	return nil
+}
+
+// matchSequences walks the lines matched by the first step and attempts to find a line for each subsequent step
+// following the previous one. Every candidate line for a step is tried until the rest of the sequence can be
+// completed. Sequences may not overlap, so a line that falls within an existing sequence cannot start a new one.
+func matchSequences(stepLines []map[string][]sequenceLine, window int32) []sequenceMatch {
+	epids := make([]string, 0, len(stepLines[0]))
+	for epid := range stepLines[0] {
+		epids = append(epids, epid)
+	}
+	sort.Strings(epids)
+
+	matches := []sequenceMatch{}
+	for _, epid := range epids {
+		lastEnd := int32(-1)
+		// lines that cannot be continued to the end of the sequence, by step.
+		deadEnds := map[[2]int32]struct{}{}
+		for _, first := range stepLines[0][epid] {
+			if first.pos <= lastEnd {
+				continue
+			}
+			match, ok := completeSequence(
+				stepLines[1:],
+				sequenceMatch{epid: epid, positions: []int32{first.pos}, score: first.score},
+				window,
+				deadEnds,
+			)
+			if !ok {
+				continue
+			}
+			matches = append(matches, match)
+			lastEnd = match.end()
+		}
+	}
+	return matches
+}
+
+// completeSequence tries each line after the end of the match, but no more than window lines away, for the
+// next step until all the remaining steps are matched.
+func completeSequence(stepLines []map[string][]sequenceLine, match sequenceMatch, window int32, deadEnds map[[2]int32]struct{}) (sequenceMatch, bool) {
+	if len(stepLines) == 0 {
+		return match, true
+	}
+	deadEnd := [2]int32{int32(len(stepLines)), match.end()}
+	if _, ok := deadEnds[deadEnd]; ok {
+		return sequenceMatch{}, false
+	}
+	lines := stepLines[0][match.epid]
+	idx := sort.Search(len(lines), func(i int) bool {
+		return lines[i].pos > match.end()
+	})
+	for ; idx < len(lines) && lines[idx].pos <= match.end()+window; idx++ {
+		next := sequenceMatch{
+			epid:      match.epid,
+			positions: append(slices.Clone(match.positions), lines[idx].pos),
+			score:     match.score + lines[idx].score,
+		}
+		if completed, ok := completeSequence(stepLines[1:], next, window, deadEnds); ok {
+			return completed, true
+		}
+	}
+	deadEnds[deadEnd] = struct{}{}
+	return sequenceMatch{}, false
+}
@@ -0,0 +1,64 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchSequences(t *testing.T) {
+	tests := map[string]struct {
+		stepLines     []map[string][]sequenceLine
+		window        int32
+		expectMatches []sequenceMatch
+	}{
+		"single step matches every line": {
+			stepLines: []map[string][]sequenceLine{
+				{"ep-1": {{pos: 1, score: 1}, {pos: 5, score: 2}}},
+			},
+			window: 3,
+			expectMatches: []sequenceMatch{
+				{epid: "ep-1", positions: []int32{1}, score: 1},
+				{epid: "ep-1", positions: []int32{5}, score: 2},
+			},
+		},
+		"second step must follow within window": {
+			stepLines: []map[string][]sequenceLine{
+				{"ep-1": {{pos: 1, score: 1}, {pos: 10, score: 1}}, "ep-2": {{pos: 3, score: 1}}},
+				{"ep-1": {{pos: 4, score: 1}, {pos: 20, score: 1}}, "ep-2": {{pos: 2, score: 1}}},
+			},
+			window: 3,
+			expectMatches: []sequenceMatch{
+				{epid: "ep-1", positions: []int32{1, 4}, score: 2},
+			},
+		},
+		"sequences do not overlap": {
+			stepLines: []map[string][]sequenceLine{
+				{"ep-1": {{pos: 1, score: 1}, {pos: 2, score: 1}, {pos: 6, score: 1}}},
+				{"ep-1": {{pos: 3, score: 1}, {pos: 7, score: 1}}},
+				{"ep-1": {{pos: 5, score: 1}, {pos: 8, score: 1}}},
+			},
+			window: 2,
+			expectMatches: []sequenceMatch{
+				{epid: "ep-1", positions: []int32{1, 3, 5}, score: 3},
+				{epid: "ep-1", positions: []int32{6, 7, 8}, score: 3},
+			},
+		},
+		"later candidate completes the sequence": {
+			stepLines: []map[string][]sequenceLine{
+				{"ep-1": {{pos: 1, score: 1}}},
+				{"ep-1": {{pos: 2, score: 1}, {pos: 4, score: 2}}},
+				{"ep-1": {{pos: 7, score: 1}}},
+			},
+			window: 3,
+			expectMatches: []sequenceMatch{
+				{epid: "ep-1", positions: []int32{1, 4, 7}, score: 4},
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.EqualValues(t, test.expectMatches, matchSequences(test.stepLines, test.window))
+		})
+	}
+}
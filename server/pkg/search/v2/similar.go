@@ -0,0 +1,155 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blugelabs/bluge"
+	"github.com/blugelabs/bluge/analysis"
+	"github.com/blugelabs/bluge/analysis/lang/en"
+	"github.com/blugelabs/bluge/analysis/token"
+	"github.com/blugelabs/bluge/analysis/tokenizer"
+	"github.com/warmans/rsk-search/gen/api"
+	"github.com/warmans/rsk-search/pkg/search"
+)
+
+const (
+	// similarMaxTerms limits the number of distinct terms taken from the source line.
+	similarMaxTerms = 25
+	// similarMinTermLength excludes very short terms which are not useful for finding similar lines.
+	similarMinTermLength = 3
+	// similarShingleBoost increases the score of lines that contain pairs of words in the same order as the source.
+	similarShingleBoost = 2
+)
+
+// similarityAnalyzer matches the analyzer used for the content field but drops stop words.
+var similarityAnalyzer = &analysis.Analyzer{
+	Tokenizer: tokenizer.NewUnicodeTokenizer(),
+	TokenFilters: []analysis.TokenFilter{
+		token.NewLowerCaseFilter(),
+		en.StopWordsFilter(),
+	},
+}
+
+func (s *Search) SearchSimilar(ctx context.Context, dialogID string, maxResults int32) (*api.SearchResultList, error) {
+	if maxResults <= 0 {
+		maxResults = PageSize
+	}
+
+	index := s.acquireIndex()
+	defer index.release()
+
+	transcriptID, content, err := findDialog(ctx, index, dialogID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.SearchResultList{Results: []*api.SearchResult{}}
+
+	query := similarQuery(content, transcriptID)
+	if query == nil {
+		return res, nil
+	}
+
+	dmi, err := index.Search(ctx, bluge.NewTopNSearch(int(maxResults), query).WithStandardAggregations())
+	if err != nil {
+		return nil, err
+	}
+	res.ResultCount = int32(dmi.Aggregations().Count())
+
+	hits := []search.Hit{}
+	next, err := dmi.Next()
+	for err == nil && next != nil {
+		var h search.Hit
+		if h, err = newHit(next); err != nil {
+			return nil, err
+		}
+		hits = append(hits, h)
+		next, err = dmi.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if res.Results, err = search.HitResults(s.episodeCache, s.logger, hits, search.DefaultContextLines, entityResult); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// findDialog returns the transcript ID and content of the line of dialog with the given ID.
+func findDialog(ctx context.Context, index *indexReader, dialogID string) (string, string, error) {
+	dmi, err := index.Search(ctx, bluge.NewTopNSearch(1, dialogOnly(bluge.NewTermQuery(dialogID).SetField("_id"))))
+	if err != nil {
+		return "", "", err
+	}
+	next, err := dmi.Next()
+	if err != nil {
+		return "", "", err
+	}
+	if next == nil {
+		return "", "", search.ErrNotFound
+	}
+	var transcriptID, content string
+	err = next.VisitStoredFields(func(field string, value []byte) bool {
+		switch field {
+		case "transcript_id":
+			transcriptID = string(value)
+		case "content":
+			content = string(value)
+		}
+		return true
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("error accessing stored fields: %v", err)
+	}
+	return transcriptID, content, nil
+}
+
+// similarQuery matches lines of dialog in other transcripts sharing a proportion of the significant terms from the
+// content. Lines that also share pairs of consecutive terms are scored higher. If the content has no significant
+// terms nil is returned.
+func similarQuery(content string, transcriptID string) bluge.Query {
+	terms := []string{}
+	shingles := []string{}
+	seen := map[string]struct{}{}
+
+	var prev string
+	for _, tok := range similarityAnalyzer.Analyze([]byte(content)) {
+		term := string(tok.Term)
+		if len([]rune(term)) < similarMinTermLength {
+			prev = ""
+			continue
+		}
+		// terms are only consecutive if no stop words were removed between them.
+		if prev != "" && tok.PositionIncr == 1 {
+			shingles = append(shingles, fmt.Sprintf("%s %s", prev, term))
+		}
+		prev = term
+		if _, ok := seen[term]; ok || len(terms) >= similarMaxTerms {
+			continue
+		}
+		seen[term] = struct{}{}
+		terms = append(terms, term)
+	}
+	if len(terms) == 0 {
+		return nil
+	}
+
+	termQuery := bluge.NewBooleanQuery().SetMinShould(max(1, len(terms)/3))
+	for _, term := range terms {
+		termQuery.AddShould(bluge.NewTermQuery(term).SetField("content"))
+	}
+
+	q := bluge.NewBooleanQuery().
+		AddMust(termQuery).
+		AddMustNot(bluge.NewTermQuery(transcriptID).SetField("transcript_id"))
+
+	if len(shingles) > 0 {
+		shingleQuery := bluge.NewBooleanQuery()
+		for _, shingle := range shingles {
+			shingleQuery.AddShould(bluge.NewMatchPhraseQuery(shingle).SetField("content").SetBoost(similarShingleBoost))
+		}
+		q.AddShould(shingleQuery)
+	}
+	return dialogOnly(q)
+}
@@ -0,0 +1,93 @@
+package v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blugelabs/bluge"
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/pkg/models/modelstest"
+	"github.com/warmans/rsk-search/pkg/search"
+)
+
+func TestSimilarQuery(t *testing.T) {
+	writer, err := bluge.OpenWriter(bluge.InMemoryOnlyConfig())
+	require.NoError(t, err)
+	defer writer.Close()
+
+	ctx := context.Background()
+	indexer := NewIndexer(writer)
+	require.NoError(t, indexer.IndexTranscript(ctx, modelstest.Transcript(
+		1,
+		[2]string{"karl", "my mate had a monkey that went to the shops"},
+		[2]string{"karl", "the monkey went to the shops"},
+		[2]string{"karl", "and then what"},
+	)))
+	require.NoError(t, indexer.IndexTranscript(ctx, modelstest.Transcript(
+		2,
+		[2]string{"karl", "a monkey went to the shops to buy some nuts"},
+		[2]string{"karl", "shops were shut"},
+		[2]string{"karl", "a tree"},
+		[2]string{"karl", "something else entirely"},
+	)))
+
+	reader, err := writer.Reader()
+	require.NoError(t, err)
+	index := &indexReader{Reader: reader}
+
+	tests := []struct {
+		name     string
+		dialogID string
+		expect   []string
+		err      error
+	}{
+		{
+			name:     "similar lines from other transcripts are returned in order",
+			dialogID: "ep-xfm-S1E01-2",
+			expect:   []string{"ep-xfm-S1E02-1", "ep-xfm-S1E02-2"},
+		},
+		{
+			name:     "only stop words",
+			dialogID: "ep-xfm-S1E01-3",
+			expect:   nil,
+		},
+		{
+			name:     "unknown line",
+			dialogID: "ep-xfm-S1E01-4",
+			err:      search.ErrNotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			transcriptID, content, err := findDialog(ctx, index, test.dialogID)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+
+			query := similarQuery(content, transcriptID)
+			if test.expect == nil {
+				require.Nil(t, query)
+				return
+			}
+			dmi, err := index.Search(ctx, bluge.NewTopNSearch(10, query))
+			require.NoError(t, err)
+
+			ids := []string{}
+			next, err := dmi.Next()
+			for err == nil && next != nil {
+				require.NoError(t, next.VisitStoredFields(func(field string, value []byte) bool {
+					if field == "_id" {
+						ids = append(ids, string(value))
+						return false
+					}
+					return true
+				}))
+				next, err = dmi.Next()
+			}
+			require.NoError(t, err)
+			require.EqualValues(t, test.expect, ids)
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package v2
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/adrg/strutil/metrics"
+	"github.com/blevesearch/vellum/levenshtein"
+	"github.com/blugelabs/bluge"
+	"github.com/warmans/rsk-search/pkg/filter"
+	"github.com/warmans/rsk-search/pkg/filter/bluge_query"
+)
+
+const (
+	MaxSuggestions = 3
+	// maxSuggestionEdits is the max edit distance between a word and its correction.
+	maxSuggestionEdits = 2
+	// suggestionEditPenalty is how many times more frequent a term must be to be preferred to a term one edit closer.
+	suggestionEditPenalty = 100
+	// words shorter than this are not corrected as almost any other short word would be a candidate.
+	minSuggestionWordLength = 3
+	// maxCandidatesPerWord limits how many alternatives are considered for a single word.
+	maxCandidatesPerWord = 50
+)
+
+var levBuilder = sync.OnceValues(func() (*levenshtein.LevenshteinAutomatonBuilder, error) {
+	return levenshtein.NewLevenshteinAutomatonBuilder(maxSuggestionEdits, true)
+})
+
+type suggestionCandidate struct {
+	term  string
+	score float64
+}
+
+// suggestQueries attempts to correct any words in the content filters that do not appear in the index. Only
+// corrected queries that would return results are suggested.
+func suggestQueries(ctx context.Context, index *indexReader, f filter.Filter) ([]string, error) {
+	if f == nil {
+		return nil, nil
+	}
+	corrections := map[string][]suggestionCandidate{}
+	for _, word := range contentWords(f) {
+		if _, ok := corrections[word]; ok || len([]rune(word)) < minSuggestionWordLength {
+			continue
+		}
+		candidates, err := correctionCandidates(index, word)
+		if err != nil {
+			return nil, err
+		}
+		if len(candidates) > 0 {
+			corrections[word] = candidates
+		}
+	}
+	if len(corrections) == 0 {
+		return nil, nil
+	}
+
+	suggestions := []string{}
+	seen := map[string]struct{}{}
+	for i := 0; i < maxCandidatesPerWord && len(suggestions) < MaxSuggestions; i++ {
+		// the nth suggestion uses the nth best candidate for each word, or the worst if there are fewer than n.
+		replacements := map[string]string{}
+		exhausted := true
+		for word, candidates := range corrections {
+			replacements[word] = candidates[min(i, len(candidates)-1)].term
+			exhausted = exhausted && i >= len(candidates)
+		}
+		if exhausted {
+			break
+		}
+		corrected := correctFilter(f, replacements)
+		printed, err := filter.Print(corrected)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[printed]; ok {
+			continue
+		}
+		seen[printed] = struct{}{}
+
+		hasResults, err := filterHasResults(ctx, index, corrected)
+		if err != nil {
+			return nil, err
+		}
+		if hasResults {
+			suggestions = append(suggestions, printed)
+		}
+	}
+	return suggestions, nil
+}
+
+// correctionCandidates returns terms within the max edit distance of the word, ordered by score. If the word
+// itself exists in the index no candidates are returned.
+func correctionCandidates(index *indexReader, word string) ([]suggestionCandidate, error) {
+	lb, err := levBuilder()
+	if err != nil {
+		return nil, err
+	}
+	dfa, err := lb.BuildDfa(word, maxSuggestionEdits)
+	if err != nil {
+		return nil, err
+	}
+	fieldDict, err := index.DictionaryIterator("content", dfa, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer fieldDict.Close()
+
+	candidates := []suggestionCandidate{}
+	tfd, err := fieldDict.Next()
+	for err == nil && tfd != nil {
+		if tfd.Term() == word {
+			return nil, nil
+		}
+		distance := metrics.NewLevenshtein().Distance(word, tfd.Term())
+		candidates = append(candidates, suggestionCandidate{
+			term:  tfd.Term(),
+			score: float64(tfd.Count()) / math.Pow(suggestionEditPenalty, float64(distance)),
+		})
+		tfd, err = fieldDict.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score > candidates[j].score
+	})
+	if len(candidates) > maxCandidatesPerWord {
+		candidates = candidates[:maxCandidatesPerWord]
+	}
+	return candidates, nil
+}
+
+func filterHasResults(ctx context.Context, index *indexReader, f filter.Filter) (bool, error) {
+	query, err := bluge_query.FilterToQuery(f)
+	if err != nil {
+		return false, err
+	}
+	dmi, err := index.Search(ctx, bluge.NewTopNSearch(1, query))
+	if err != nil {
+		return false, err
+	}
+	next, err := dmi.Next()
+	if err != nil {
+		return false, err
+	}
+	return next != nil, nil
+}
+
+// contentWords returns the lowercase words from all non-negated content filters.
+func contentWords(f filter.Filter) []string {
+	words := []string{}
+	switch f := f.(type) {
+	case *filter.BoolFilter:
+		words = append(words, contentWords(f.LHS)...)
+		words = append(words, contentWords(f.RHS)...)
+	case *filter.CompFilter:
+		if !isCorrectableFilter(f) {
+			return words
+		}
+		words = append(words, strings.FieldsFunc(strings.ToLower(f.Value.Value().(string)), isWordSeparator)...)
+	}
+	return words
+}
+
+// correctFilter returns a copy of the filter with the content words replaced.
+func correctFilter(f filter.Filter, replacements map[string]string) filter.Filter {
+	switch f := f.(type) {
+	case *filter.BoolFilter:
+		return &filter.BoolFilter{LHS: correctFilter(f.LHS, replacements), Op: f.Op, RHS: correctFilter(f.RHS, replacements)}
+	case *filter.CompFilter:
+		if !isCorrectableFilter(f) {
+			return f
+		}
+		words := strings.FieldsFunc(f.Value.Value().(string), isWordSeparator)
+		for k, w := range words {
+			if replacement, ok := replacements[strings.ToLower(w)]; ok {
+				words[k] = replacement
+			}
+		}
+		corrected := strings.Join(words, " ")
+		if proximity, ok := f.Value.(filter.ProximityValue); ok {
+			return &filter.CompFilter{Field: f.Field, Op: f.Op, Value: filter.Proximity(corrected, proximity.Distance)}
+		}
+		return &filter.CompFilter{Field: f.Field, Op: f.Op, Value: filter.String(corrected)}
+	}
+	return f
+}
+
+func isCorrectableFilter(f *filter.CompFilter) bool {
+	if f.Field != "content" {
+		return false
+	}
+	switch f.Op {
+	case filter.CompOpEq, filter.CompOpLike, filter.CompOpFuzzyLike, filter.CompOpNear:
+		_, ok := f.Value.Value().(string)
+		return ok
+	}
+	return false
+}
+
+func isWordSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '\''
+}
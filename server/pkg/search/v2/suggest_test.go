@@ -0,0 +1,81 @@
+package v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blugelabs/bluge"
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/pkg/filter"
+	"github.com/warmans/rsk-search/pkg/models/modelstest"
+)
+
+func TestSuggestQueries(t *testing.T) {
+	writer, err := bluge.OpenWriter(bluge.InMemoryOnlyConfig())
+	require.NoError(t, err)
+	defer writer.Close()
+
+	ctx := context.Background()
+	require.NoError(t, NewIndexer(writer).IndexTranscript(ctx, modelstest.Transcript(
+		1,
+		[2]string{"karl", "karl pilkington"},
+		[2]string{"karl", "pilkington again"},
+		[2]string{"karl", "he is a little englishman"},
+		[2]string{"karl", "monkey news"},
+		[2]string{"karl", "monkey nuts"},
+		[2]string{"karl", "monkey nuts"},
+	)))
+
+	reader, err := writer.Reader()
+	require.NoError(t, err)
+	index := &indexReader{Reader: reader}
+
+	tests := []struct {
+		name   string
+		filter filter.Filter
+		expect []string
+	}{
+		{
+			name:   "no filter",
+			filter: nil,
+			expect: nil,
+		},
+		{
+			name:   "nothing to correct",
+			filter: filter.Eq("content", filter.String("monkey news")),
+			expect: nil,
+		},
+		{
+			name:   "single word",
+			filter: filter.Eq("content", filter.String("pilkingten")),
+			expect: []string{`content = "pilkington"`},
+		},
+		{
+			name:   "more frequent term preferred at the same distance",
+			filter: filter.Like("content", filter.String("monkey nots")),
+			expect: []string{`content ~= "monkey nuts"`, `content ~= "monkey news"`},
+		},
+		{
+			name:   "other fields are retained",
+			filter: filter.And(filter.Eq("actor", filter.String("karl")), filter.Near("content", filter.Proximity("karl pilkingtn", 1))),
+			expect: []string{`actor = "karl" and content near "karl pilkington"~1`},
+		},
+		{
+			name:   "suggestions must have results",
+			filter: filter.Eq("content", filter.String("pilkingten news")),
+			expect: []string{},
+		},
+		{
+			name:   "negated terms are not corrected",
+			filter: filter.Neq("content", filter.String("pilkingten")),
+			expect: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			suggestions, err := suggestQueries(ctx, index, test.filter)
+			require.NoError(t, err)
+			require.EqualValues(t, test.expect, suggestions)
+		})
+	}
+}
@@ -3,16 +3,12 @@ package searchterms
 import (
 	"github.com/pkg/errors"
 	"github.com/warmans/rsk-search/pkg/filter"
+	"strconv"
 	"strings"
 )
 
-type Term struct {
-	Field string
-	Value string
-	Op    filter.CompOp
-}
-
-func MustParse(s string) []Term {
+// MustParse parses the search terms and panics on error.
+func MustParse(s string) filter.Filter {
 	f, err := Parse(s)
 	if err != nil {
 		panic(err)
@@ -20,8 +16,12 @@ func MustParse(s string) []Term {
 	return f
 }
 
-func Parse(s string) ([]Term, error) {
-	if s == "" {
+// Parse converts search bar terms into a filter. Terms are implicitly ANDed, but can be combined with OR
+// (which must be uppercase) and grouped with parens. A term prefixed with a dash is negated e.g.
+//
+//	(actor:karl OR @steve) "monkey" -"news"
+func Parse(s string) (filter.Filter, error) {
+	if strings.TrimSpace(s) == "" {
 		return nil, nil
 	}
 	return newParser(newScanner(s)).Parse()
@@ -36,47 +36,122 @@ type parser struct {
 	peeked *token
 }
 
-func (p *parser) Parse() ([]Term, error) {
-	terms, err := p.parseOuter()
+func (p *parser) Parse() (filter.Filter, error) {
+	f, err := p.parseOr()
 	if err != nil {
 		return nil, err
 	}
 	if _, err := p.requireNext(tagEOF); err != nil {
 		return nil, err
 	}
-	return terms, nil
+	return f, nil
 }
 
-func (p *parser) parseOuter() ([]Term, error) {
-	terms := []Term{}
-	term, err := p.parseInner()
+func (p *parser) parseOr() (filter.Filter, error) {
+	f, err := p.parseAnd()
 	if err != nil {
 		return nil, err
 	}
-	for term != nil {
-		terms = append(terms, *term)
-		term, err = p.parseInner()
+	for {
+		next, err := p.peekNext()
+		if err != nil {
+			return nil, err
+		}
+		if next.tag != tagOr {
+			return f, nil
+		}
+		if _, err := p.getNext(); err != nil {
+			return nil, err
+		}
+		rhs, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		f = filter.Or(f, rhs)
+	}
+}
+
+// parseAnd consumes terms until the end of the group is reached. Adjacent terms have no explicit
+// operator, so they are joined with AND.
+func (p *parser) parseAnd() (filter.Filter, error) {
+	var f filter.Filter
+	for {
+		next, err := p.peekNext()
 		if err != nil {
 			return nil, err
 		}
+		if next.tag == tagEOF || next.tag == tagRParen || next.tag == tagOr {
+			break
+		}
+		term, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if f == nil {
+			f = term
+		} else {
+			f = filter.And(f, term)
+		}
+	}
+	if f == nil {
+		next, err := p.peekNext()
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.Errorf("expected term, found '%s'", next.tag)
+	}
+	return f, nil
+}
+
+func (p *parser) parseUnary() (filter.Filter, error) {
+	next, err := p.peekNext()
+	if err != nil {
+		return nil, err
 	}
-	return terms, nil
+	if next.tag != tagNot {
+		return p.parseTerm()
+	}
+	if _, err := p.getNext(); err != nil {
+		return nil, err
+	}
+	f, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return negate(f), nil
 }
 
-func (p *parser) parseInner() (*Term, error) {
+func (p *parser) parseTerm() (filter.Filter, error) {
 	tok, err := p.getNext()
 	if err != nil {
 		return nil, err
 	}
 	switch tok.tag {
-	case tagEOF:
-		return nil, nil
+	case tagLParen:
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.requireNext(tagRParen); err != nil {
+			return nil, err
+		}
+		return f, nil
 	case tagQuotedString:
-		return &Term{
-			Field: "content",
-			Value: strings.Trim(tok.lexeme, `"`),
-			Op:    filter.CompOpEq,
-		}, nil
+		next, err := p.peekNext()
+		if err != nil {
+			return nil, err
+		}
+		if next.tag == tagProximity {
+			if _, err := p.getNext(); err != nil {
+				return nil, err
+			}
+			distance, err := strconv.ParseInt(next.lexeme, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid proximity '%s'", next.lexeme)
+			}
+			return filter.Near("content", filter.Proximity(strings.Trim(tok.lexeme, `"`), distance)), nil
+		}
+		return filter.Eq("content", filter.String(strings.Trim(tok.lexeme, `"`))), nil
 	case tagWord:
 		words := []string{tok.lexeme}
 		next, err := p.peekNext()
@@ -96,31 +171,28 @@ func (p *parser) parseInner() (*Term, error) {
 				return nil, err
 			}
 		}
-		return &Term{
-			Field: "content",
-			Value: strings.Join(words, " "),
-			Op:    filter.CompOpFuzzyLike,
-		}, nil
+		return filter.FuzzyLike("content", filter.String(strings.Join(words, " "))), nil
 	case tagMention:
 		mentionText, err := p.requireNext(tagQuotedString, tagWord, tagEOF)
 		if err != nil {
 			return nil, err
 		}
-		return &Term{
-			Field: "actor",
-			Value: strings.ToLower(mentionText.lexeme),
-			Op:    filter.CompOpEq,
-		}, nil
+		return filter.Eq("actor", filter.String(strings.ToLower(mentionText.lexeme))), nil
 	case tagPublication:
 		mentionText, err := p.requireNext(tagQuotedString, tagWord, tagEOF)
 		if err != nil {
 			return nil, err
 		}
-		return &Term{
-			Field: "publication",
-			Value: strings.ToLower(mentionText.lexeme),
-			Op:    filter.CompOpEq,
-		}, nil
+		return filter.Eq("publication", filter.String(strings.ToLower(mentionText.lexeme))), nil
+	case tagField:
+		value, err := p.requireNext(tagQuotedString, tagWord)
+		if err != nil {
+			return nil, err
+		}
+		if tok.lexeme == "content" {
+			return filter.Eq(tok.lexeme, filter.String(value.lexeme)), nil
+		}
+		return filter.Eq(tok.lexeme, filter.String(strings.ToLower(value.lexeme))), nil
 	default:
 		return nil, errors.Errorf("unexpected token '%s'", tok)
 	}
@@ -166,3 +238,35 @@ func (p *parser) requireNext(oneOf ...tag) (token, error) {
 	}
 	return token{}, errors.Errorf("expected one of '%v', found '%s'", oneOf, t.tag)
 }
+
+// negate inverts the filter by pushing the negation down to the comparisons (De Morgan).
+// Comparisons with no inverse operator e.g. like are wrapped in a NOT.
+func negate(f filter.Filter) filter.Filter {
+	switch f := f.(type) {
+	case *filter.NotFilter:
+		return f.Filter
+	case *filter.BoolFilter:
+		if f.Op == filter.BoolOpAnd {
+			return filter.Or(negate(f.LHS), negate(f.RHS))
+		}
+		return filter.And(negate(f.LHS), negate(f.RHS))
+	case *filter.CompFilter:
+		switch f.Op {
+		case filter.CompOpNeq:
+			return filter.Eq(f.Field, f.Value)
+		case filter.CompOpLt:
+			return filter.Ge(f.Field, f.Value)
+		case filter.CompOpLe:
+			return filter.Gt(f.Field, f.Value)
+		case filter.CompOpGt:
+			return filter.Le(f.Field, f.Value)
+		case filter.CompOpGe:
+			return filter.Lt(f.Field, f.Value)
+		case filter.CompOpEq:
+			return filter.Neq(f.Field, f.Value)
+		default:
+			return filter.Not(f)
+		}
+	}
+	return f
+}
@@ -13,60 +13,155 @@ func TestMustParse(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want []Term
+		want filter.Filter
 	}{
+		{
+			name: "parse empty",
+			args: args{s: "  "},
+			want: nil,
+		},
 		{
 			name: "parse word",
 			args: args{s: "foo"},
-			want: []Term{{Field: "content", Value: "foo", Op: filter.CompOpFuzzyLike}},
+			want: filter.FuzzyLike("content", filter.String("foo")),
 		},
 		{
 			name: "parse words",
 			args: args{s: "foo bar baz"},
-			want: []Term{{Field: "content", Value: "foo bar baz", Op: filter.CompOpFuzzyLike}},
+			want: filter.FuzzyLike("content", filter.String("foo bar baz")),
 		},
 		{
 			name: "parse quoted string",
 			args: args{s: `"foo bar"`},
-			want: []Term{{Field: "content", Value: "foo bar", Op: filter.CompOpEq}},
+			want: filter.Eq("content", filter.String("foo bar")),
 		},
 		{
 			name: "parse quoted strings",
 			args: args{s: `"foo bar" "baz"`},
-			want: []Term{
-				{Field: "content", Value: "foo bar", Op: filter.CompOpEq},
-				{Field: "content", Value: "baz", Op: filter.CompOpEq},
-			},
+			want: filter.And(
+				filter.Eq("content", filter.String("foo bar")),
+				filter.Eq("content", filter.String("baz")),
+			),
 		},
 		{
 			name: "parse publication",
 			args: args{s: `~xfm`},
-			want: []Term{
-				{Field: "publication", Value: "xfm", Op: filter.CompOpEq},
-			},
+			want: filter.Eq("publication", filter.String("xfm")),
 		},
 		{
 			name: "parse mention",
 			args: args{s: `@steve`},
-			want: []Term{
-				{Field: "actor", Value: "steve", Op: filter.CompOpEq},
-			},
+			want: filter.Eq("actor", filter.String("steve")),
+		},
+		{
+			name: "parse field",
+			args: args{s: `actor:Karl`},
+			want: filter.Eq("actor", filter.String("karl")),
 		},
 		{
 			name: "parse all",
 			args: args{s: `@steve ~xfm "man alive" karl`},
-			want: []Term{
-				{Field: "actor", Value: "steve", Op: filter.CompOpEq},
-				{Field: "publication", Value: "xfm", Op: filter.CompOpEq},
-				{Field: "content", Value: "man alive", Op: filter.CompOpEq},
-				{Field: "content", Value: "karl", Op: filter.CompOpFuzzyLike},
-			},
+			want: filter.And(
+				filter.Eq("actor", filter.String("steve")),
+				filter.Eq("publication", filter.String("xfm")),
+				filter.Eq("content", filter.String("man alive")),
+				filter.FuzzyLike("content", filter.String("karl")),
+			),
+		},
+		{
+			name: "parse or",
+			args: args{s: `"foo" OR bar OR @karl`},
+			want: filter.Or(
+				filter.Or(
+					filter.Eq("content", filter.String("foo")),
+					filter.FuzzyLike("content", filter.String("bar")),
+				),
+				filter.Eq("actor", filter.String("karl")),
+			),
+		},
+		{
+			name: "lowercase or is a word",
+			args: args{s: `to be or not to be`},
+			want: filter.FuzzyLike("content", filter.String("to be or not to be")),
+		},
+		{
+			name: "and binds tighter than or",
+			args: args{s: `@karl "foo" OR @steve "bar"`},
+			want: filter.Or(
+				filter.And(filter.Eq("actor", filter.String("karl")), filter.Eq("content", filter.String("foo"))),
+				filter.And(filter.Eq("actor", filter.String("steve")), filter.Eq("content", filter.String("bar"))),
+			),
+		},
+		{
+			name: "parse negation",
+			args: args{s: `monkey -"news" -@steve`},
+			want: filter.And(
+				filter.FuzzyLike("content", filter.String("monkey")),
+				filter.Neq("content", filter.String("news")),
+				filter.Neq("actor", filter.String("steve")),
+			),
+		},
+		{
+			name: "parse negated like",
+			args: args{s: `-pilk -"news"`},
+			want: filter.And(
+				filter.Not(filter.FuzzyLike("content", filter.String("pilk"))),
+				filter.Neq("content", filter.String("news")),
+			),
+		},
+		{
+			name: "dash inside word is not negation",
+			args: args{s: `round-headed - buffoon`},
+			want: filter.FuzzyLike("content", filter.String("round-headed - buffoon")),
+		},
+		{
+			name: "parse groups",
+			args: args{s: `(actor:karl OR actor:steve) "monkey" -"news"`},
+			want: filter.And(
+				filter.Or(
+					filter.Eq("actor", filter.String("karl")),
+					filter.Eq("actor", filter.String("steve")),
+				),
+				filter.Eq("content", filter.String("monkey")),
+				filter.Neq("content", filter.String("news")),
+			),
+		},
+		{
+			name: "parse proximity",
+			args: args{s: `"pilkington moon"~5 ~xfm`},
+			want: filter.And(
+				filter.Near("content", filter.Proximity("pilkington moon", 5)),
+				filter.Eq("publication", filter.String("xfm")),
+			),
+		},
+		{
+			name: "parse negated proximity",
+			args: args{s: `-"pilkington moon"~5`},
+			want: filter.Not(filter.Near("content", filter.Proximity("pilkington moon", 5))),
+		},
+		{
+			name: "parse negated group",
+			args: args{s: `-(@karl OR -"foo")`},
+			want: filter.And(
+				filter.Neq("actor", filter.String("karl")),
+				filter.Eq("content", filter.String("foo")),
+			),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if got := MustParse(tt.args.s); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("MustParse() = %v, want %v", got, tt.want)
+				t.Errorf("MustParse() = %v, want %v", filter.MustPrint(got), filter.MustPrint(tt.want))
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, s := range []string{`(foo`, `foo)`, `OR foo`, `foo OR`, `()`, `foo -)`} {
+		t.Run(s, func(t *testing.T) {
+			if _, err := Parse(s); err == nil {
+				t.Errorf("Parse() expected error for %s", s)
 			}
 		})
 	}
@@ -14,10 +14,23 @@ const (
 	tagMention     = "@"
 	tagPublication = "~"
 
+	tagLParen = "("
+	tagRParen = ")"
+	tagNot    = "-"
+	tagOr     = "OR"
+
+	tagProximity = "PROXIMITY"
+
+	tagField        = "FIELD"
 	tagQuotedString = "QUOTED_STRING"
 	tagWord         = "WORD"
 )
 
+// keywords are case-sensitive so lowercase words are still searched for e.g. "to be or not to be"
+var keywords = map[string]tag{
+	"OR": tagOr,
+}
+
 type token struct {
 	tag    tag
 	lexeme string
@@ -74,7 +87,22 @@ func (s *scanner) next() (token, error) {
 	case '@':
 		return s.emit(tagMention), nil
 	case '~':
+		// a tilde immediately after a quoted string followed by a number is a proximity e.g. "foo bar"~5
+		if s.offset > 0 && s.input[s.offset-1] == '"' && !s.atEOF() && isNumber(s.peekRune()) {
+			return s.scanProximity()
+		}
 		return s.emit(tagPublication), nil
+	case '(':
+		return s.emit(tagLParen), nil
+	case ')':
+		return s.emit(tagRParen), nil
+	case '-':
+		// a dash is only a negation if it prefixes a term e.g. -"foo" otherwise
+		// it is just part of the word.
+		if !s.atEOF() && !isWhitespace(s.peekRune()) {
+			return s.emit(tagNot), nil
+		}
+		return s.scanWord()
 	case '"':
 		return s.scanString()
 	default:
@@ -119,9 +147,41 @@ func (s *scanner) scanString() (token, error) {
 
 func (s *scanner) scanWord() (token, error) {
 	for !s.atEOF() && isValidInputRune(s.peekRune()) && !isWhitespace(s.peekRune()) {
+		// field names are terminated by a colon and immediately followed by the value e.g. actor:steve
+		if s.peekRune() == ':' && s.atFieldName() {
+			s.nextRune()
+			return trimTokenLexeme(s.emit(tagField), ":"), nil
+		}
 		s.nextRune()
 	}
-	return s.emit(tagWord), nil
+	tok := s.emit(tagWord)
+	if tag, ok := keywords[tok.lexeme]; ok {
+		tok.tag = tag
+	}
+	return tok, nil
+}
+
+// atFieldName checks if the word scanned so far is a valid field name and is followed by a value.
+func (s *scanner) atFieldName() bool {
+	if s.pos == s.offset || s.pos+1 >= len(s.input) {
+		return false
+	}
+	if next := s.input[s.pos+1]; isWhitespace(next) || next == '(' || next == ')' {
+		return false
+	}
+	for _, r := range s.input[s.offset:s.pos] {
+		if !isValidFieldRune(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *scanner) scanProximity() (token, error) {
+	for !s.atEOF() && isNumber(s.peekRune()) {
+		s.nextRune()
+	}
+	return trimTokenLexeme(s.emit(tagProximity), "~"), nil
 }
 
 func (s *scanner) atEOF() bool {
@@ -147,7 +207,15 @@ func isWhitespace(r rune) bool {
 }
 
 func isValidInputRune(r rune) bool {
-	return r != '@' && r != '~' && r != '"'
+	return r != '@' && r != '~' && r != '"' && r != '(' && r != ')'
+}
+
+func isNumber(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isValidFieldRune(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r == '_'
 }
 
 func trimTokenLexeme(t token, trimSet string) token {
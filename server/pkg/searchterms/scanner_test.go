@@ -75,6 +75,49 @@ func TestScan(t *testing.T) {
 				{tag: tagEOF}},
 			wantErr: false,
 		},
+		{
+			name: "scan groups",
+			args: args{
+				str: `(actor:karl OR @steve) -"foo"`,
+			},
+			want: []token{
+				{tag: tagLParen, lexeme: "("},
+				{tag: tagField, lexeme: "actor"},
+				{tag: tagWord, lexeme: "karl"},
+				{tag: tagOr, lexeme: "OR"},
+				{tag: tagMention, lexeme: "@"},
+				{tag: tagWord, lexeme: "steve"},
+				{tag: tagRParen, lexeme: ")"},
+				{tag: tagNot, lexeme: "-"},
+				{tag: tagQuotedString, lexeme: "foo"},
+				{tag: tagEOF}},
+			wantErr: false,
+		},
+		{
+			name: "scan proximity",
+			args: args{
+				str: `"foo bar"~12 "baz"~xfm`,
+			},
+			want: []token{
+				{tag: tagQuotedString, lexeme: "foo bar"},
+				{tag: tagProximity, lexeme: "12"},
+				{tag: tagQuotedString, lexeme: "baz"},
+				{tag: tagPublication, lexeme: "~"},
+				{tag: tagWord, lexeme: "xfm"},
+				{tag: tagEOF}},
+			wantErr: false,
+		},
+		{
+			name: "scan colon without value",
+			args: args{
+				str: `said: 12:30`,
+			},
+			want: []token{
+				{tag: tagWord, lexeme: "said:"},
+				{tag: tagWord, lexeme: "12:30"},
+				{tag: tagEOF}},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
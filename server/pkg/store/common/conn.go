@@ -12,6 +12,7 @@ import (
 	"io"
 	"path"
 	"strings"
+	"sync"
 )
 
 type Config struct {
@@ -38,16 +39,20 @@ func NewConn(driver string, cfg *Config) (*Conn, error) {
 }
 
 type Conn struct {
-	db *sqlx.DB
+	db   *sqlx.DB
+	lock sync.RWMutex
 }
 
 func (c *Conn) Migrate(migrations embed.FS) error {
 
-	_, err := c.db.Exec(`
-		CREATE TABLE IF NOT EXISTS migration_log (
-		  file_name TEXT PRIMARY KEY
-		);
-	`)
+	err := c.WithTx(func(tx *sqlx.Tx) error {
+		_, err := tx.Exec(`
+			CREATE TABLE IF NOT EXISTS migration_log (
+			  file_name TEXT PRIMARY KEY
+			);
+		`)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to create metadata table: %w", err)
 	}
@@ -114,6 +119,9 @@ func (c *Conn) Migrate(migrations embed.FS) error {
 }
 
 func (c *Conn) WithTx(f func(tx *sqlx.Tx) error) error {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
 	tx, err := c.db.Beginx()
 	if err != nil {
 		return fmt.Errorf("failed to start transaction: %w", err)
@@ -127,6 +135,20 @@ func (c *Conn) WithTx(f func(tx *sqlx.Tx) error) error {
 	return tx.Commit()
 }
 
+// Swap replaces the underlying DB with the one from other once any transactions opened with WithTx have completed.
+// All access to the DB goes through WithTx so nothing can still be using the previous DB when it is closed.
+// other should not be used after it has been swapped in.
+func (c *Conn) Swap(other *Conn) error {
+	c.lock.Lock()
+	old := c.db
+	c.db = other.db
+	c.lock.Unlock()
+
+	return old.Close()
+}
+
 func (c *Conn) Close() error {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
 	return c.db.Close()
 }
@@ -124,11 +124,15 @@ func (q *QueryModifier) Apply(opt QueryOpt) *QueryModifier {
 }
 
 func (q *QueryModifier) ToSQL(fieldMap map[string]string, withWHERE bool) (where string, params []interface{}, order string, paging string, err error) {
+	return q.ToDialectSQL(psql.DialectPostgres, fieldMap, withWHERE)
+}
+
+func (q *QueryModifier) ToDialectSQL(dialect psql.Dialect, fieldMap map[string]string, withWHERE bool) (where string, params []interface{}, order string, paging string, err error) {
 	if q == nil {
 		return "", []interface{}{}, "", "", nil
 	}
 	if q.Filter != nil {
-		where, params, err = psql.FilterToQuery(q.Filter, fieldMap)
+		where, params, err = psql.FilterToDialectQuery(dialect, q.Filter, fieldMap)
 		if err != nil {
 			return
 		}
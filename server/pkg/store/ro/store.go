@@ -2,12 +2,18 @@ package ro
 
 import (
 	"context"
+	"database/sql"
 	"embed"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/karlseguin/ccache/v2"
+	"github.com/mattn/go-sqlite3"
 	"github.com/pkg/errors"
+	"github.com/warmans/rsk-search/pkg/filter/psql"
 	"github.com/warmans/rsk-search/pkg/meta"
 	"github.com/warmans/rsk-search/pkg/models"
 	"github.com/warmans/rsk-search/pkg/store/common"
@@ -17,8 +23,38 @@ import (
 //go:embed migrations
 var migrations embed.FS
 
+// driverName is the sqlite3 driver with a REGEXP function so regex filters can be used.
+const driverName = "sqlite3_regexp"
+
+const (
+	regexpCacheSize = 100
+	regexpCacheTTL  = time.Hour
+)
+
+// regexpCache holds compiled patterns as the REGEXP function is called for every row.
+var regexpCache = ccache.New(ccache.Configure().MaxSize(regexpCacheSize).ItemsToPrune(regexpCacheSize / 10))
+
+func init() {
+	sql.Register(driverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc("regexp", matchRegexp, true)
+		},
+	})
+}
+
+// matchRegexp implements REGEXP. An invalid pattern fails the query on the first row.
+func matchRegexp(pattern string, value string) (bool, error) {
+	item, err := regexpCache.Fetch(pattern, regexpCacheTTL, func() (interface{}, error) {
+		return regexp.Compile(pattern)
+	})
+	if err != nil {
+		return false, err
+	}
+	return item.Value().(*regexp.Regexp).MatchString(value), nil
+}
+
 func NewConn(cfg *common.Config) (*Conn, error) {
-	innerConn, err := common.NewConn("sqlite3", cfg)
+	innerConn, err := common.NewConn(driverName, cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -93,19 +129,6 @@ func (s *Store) InsertEpisodeWithTranscript(ctx context.Context, ep *models.Tran
 	return err
 }
 
-func (s *Store) GetDialogWithContext(ctx context.Context, dialogID string, withContext int32) ([]models.Dialog, string, error) {
-	query := fmt.Sprintf(`
-		WITH target AS (SELECT * FROM dialog WHERE id = $1 LIMIT 1)
-		SELECT * FROM (SELECT * FROM dialog WHERE pos < (SELECT pos FROM target) AND episode_id = (SELECT episode_id FROM target) ORDER BY pos DESC LIMIT %d)
-		UNION 
-		SELECT * FROM target
-		UNION
-		SELECT * FROM (SELECT * FROM dialog WHERE pos > (SELECT pos FROM target) AND episode_id = (SELECT episode_id FROM target) ORDER BY pos ASC LIMIT %d)
-		ORDER BY pos ASC`, withContext, withContext)
-
-	return s.getTranscriptForQuery(ctx, query, dialogID)
-}
-
 func (s *Store) InsertChangelog(ctx context.Context, ep *models.Changelog) error {
 	_, err := s.tx.ExecContext(
 		ctx,
@@ -129,7 +152,7 @@ func (s *Store) ListEpisodes(ctx context.Context, q *common.QueryModifier) ([]*m
 
 	q.Apply(common.WithDefaultSorting("release_date", common.SortAsc))
 
-	where, params, order, paging, err := q.ToSQL(fieldMap, true)
+	where, params, order, paging, err := q.ToDialectSQL(psql.DialectSQLite, fieldMap, true)
 	if err != nil {
 		return nil, err
 	}
@@ -161,6 +184,9 @@ func (s *Store) ListEpisodes(ctx context.Context, q *common.QueryModifier) ([]*m
 		}
 		result = append(result, row)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
@@ -172,7 +198,7 @@ func (s *Store) ListChangelogs(ctx context.Context, q *common.QueryModifier) ([]
 
 	q.Apply(common.WithDefaultSorting("date", common.SortDesc))
 
-	where, params, order, paging, err := q.ToSQL(fieldMap, true)
+	where, params, order, paging, err := q.ToDialectSQL(psql.DialectSQLite, fieldMap, true)
 	if err != nil {
 		return nil, err
 	}
@@ -197,40 +223,10 @@ func (s *Store) ListChangelogs(ctx context.Context, q *common.QueryModifier) ([]
 		}
 		out = append(out, row)
 	}
-	return out, nil
-}
-
-func (s *Store) getTranscriptForQuery(ctx context.Context, query string, params ...interface{}) ([]models.Dialog, string, error) {
-
-	res, err := s.tx.QueryxContext(ctx, query, params...)
-	if err != nil {
-		return nil, "", err
-	}
-	defer func(res *sqlx.Rows) {
-		_ = res.Close()
-	}(res)
-
-	var epID string
-
-	results := make([]models.Dialog, 0)
-	for res.Next() {
-
-		result := models.Dialog{
-			Meta: make(models.Metadata),
-		}
-		var meta string
-
-		if err := res.Scan(&result.ID, &epID, &result.Position, &result.Timestamp, &result.TimestampInferred, &result.Type, &result.Actor, &result.Content, &meta, &result.Notable); err != nil {
-			return nil, "", err
-		}
-		if meta != "" {
-			if err := json.Unmarshal([]byte(meta), &result.Meta); err != nil {
-				return nil, "", errors.Wrap(err, "failed to unmarshal meta")
-			}
-		}
-		results = append(results, result)
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
-	return results, epID, nil
+	return out, nil
 }
 
 func (s *Store) InsertSong(ctx context.Context, song meta.Song) error {
@@ -266,7 +262,7 @@ func (s *Store) ListSongs(ctx context.Context, q *common.QueryModifier) ([]*mode
 
 	q.Apply(common.WithDefaultSorting("title", common.SortAsc))
 
-	where, params, order, paging, err := q.ToSQL(fieldMap, true)
+	where, params, order, paging, err := q.ToDialectSQL(psql.DialectSQLite, fieldMap, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -304,6 +300,9 @@ func (s *Store) ListSongs(ctx context.Context, q *common.QueryModifier) ([]*mode
 		}
 		out = append(out, row)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
 	return out, totalRows, nil
 }
 
@@ -329,7 +328,7 @@ func (s *Store) ListCommunityProjects(ctx context.Context, q *common.QueryModifi
 	}
 	q.Apply(common.WithDefaultSorting("created_at", common.SortAsc))
 
-	where, params, order, paging, err := q.ToSQL(fieldMap, true)
+	where, params, order, paging, err := q.ToDialectSQL(psql.DialectSQLite, fieldMap, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -355,6 +354,9 @@ func (s *Store) ListCommunityProjects(ctx context.Context, q *common.QueryModifi
 		}
 		out = append(out, row)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
 	return out, totalRows, nil
 }
 
@@ -0,0 +1,70 @@
+package ro
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/pkg/filter"
+	"github.com/warmans/rsk-search/pkg/meta"
+	"github.com/warmans/rsk-search/pkg/spotify"
+	"github.com/warmans/rsk-search/pkg/store/common"
+)
+
+func TestListSongsRegex(t *testing.T) {
+	conn, err := NewConn(&common.Config{DSN: path.Join(t.TempDir(), "ro.sqlite3")})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	require.NoError(t, conn.Migrate())
+
+	ctx := context.Background()
+	require.NoError(t, conn.WithStore(func(s *Store) error {
+		for _, title := range []string{"Bar", "Baz", "Foo"} {
+			song := meta.Song{Track: &spotify.Track{TrackURI: title, Name: title, Artists: []spotify.Artist{{Name: "karl"}}}}
+			if err := s.InsertSong(ctx, song); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	tests := []struct {
+		name   string
+		filter filter.Filter
+		expect []string
+	}{
+		{
+			name:   "regex",
+			filter: filter.Regex("title", filter.String("^Ba[rz]$")),
+			expect: []string{"Bar", "Baz"},
+		},
+		{
+			name:   "negated regex",
+			filter: filter.Not(filter.Regex("title", filter.String("^Ba"))),
+			expect: []string{"Foo"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, conn.WithStore(func(s *Store) error {
+				songs, total, err := s.ListSongs(ctx, common.Q(common.WithFilter(tt.filter)))
+				if err != nil {
+					return err
+				}
+				titles := []string{}
+				for _, song := range songs {
+					titles = append(titles, song.Title)
+				}
+				require.EqualValues(t, tt.expect, titles)
+				require.EqualValues(t, len(tt.expect), total)
+				return nil
+			}))
+		})
+	}
+
+	require.Error(t, conn.WithStore(func(s *Store) error {
+		_, _, err := s.ListSongs(ctx, common.Q(common.WithFilter(filter.Regex("title", filter.String("Ba(")))))
+		return err
+	}))
+}
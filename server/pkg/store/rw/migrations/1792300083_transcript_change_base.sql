@@ -0,0 +1,2 @@
+ALTER TABLE "transcript_change"
+    ADD COLUMN base_transcription TEXT;
\ No newline at end of file
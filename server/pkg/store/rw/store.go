@@ -894,7 +894,7 @@ func (s *Store) GetTranscriptChange(ctx context.Context, id string) (*models.Tra
 	var releaseDate *time.Time
 
 	err := s.tx.
-		QueryRowxContext(ctx, `SELECT id, author_id, epid, COALESCE(transcript_version, 'NONE'), name, summary, release_date, transcription, state, created_at, merged FROM transcript_change WHERE id=$1`, id).
+		QueryRowxContext(ctx, `SELECT id, author_id, epid, COALESCE(transcript_version, 'NONE'), name, summary, release_date, transcription, COALESCE(base_transcription, ''), state, created_at, merged FROM transcript_change WHERE id=$1`, id).
 		Scan(
 			&change.ID,
 			&authorID,
@@ -904,6 +904,7 @@ func (s *Store) GetTranscriptChange(ctx context.Context, id string) (*models.Tra
 			&change.Summary,
 			&releaseDate,
 			&change.Transcription,
+			&change.BaseTranscription,
 			&change.State,
 			&change.CreatedAt,
 			&change.Merged,
@@ -940,6 +941,7 @@ func (s *Store) CreateTranscriptChange(ctx context.Context, c *models.Transcript
 		Summary:           c.Summary,
 		ReleaseDate:       c.ReleaseDate,
 		Transcription:     c.Transcription,
+		BaseTranscription: c.BaseTranscription,
 		State:             models.ContributionStatePending,
 		CreatedAt:         time.Now(),
 		TranscriptVersion: c.TranscriptVersion,
@@ -955,9 +957,10 @@ func (s *Store) CreateTranscriptChange(ctx context.Context, c *models.Transcript
 		   summary,
 		   release_date,
 		   transcription,
+		   base_transcription,
 		   state,
 		   created_at
-		   ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		   ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
 		change.ID,
 		change.Author.ID,
 		change.EpID,
@@ -966,6 +969,7 @@ func (s *Store) CreateTranscriptChange(ctx context.Context, c *models.Transcript
 		change.Summary,
 		change.ReleaseDate.Format(util.SQLDateFormat),
 		change.Transcription,
+		change.BaseTranscription,
 		models.ContributionStatePending,
 		change.CreatedAt.Format(util.SQLDateFormat),
 	)
@@ -1097,6 +1101,7 @@ func (s *Store) ListTranscriptChanges(ctx context.Context, q *common.QueryModifi
 		    c.summary, 
 		    c.release_date, 
 		    c.transcription, 
+		    COALESCE(c.base_transcription, ''), 
 		    c.state, 
 		    c.created_at,
 		    c.merged, 
@@ -1132,6 +1137,7 @@ func (s *Store) ListTranscriptChanges(ctx context.Context, q *common.QueryModifi
 			&cur.Summary,
 			&episodeReleaseDate,
 			&cur.Transcription,
+			&cur.BaseTranscription,
 			&cur.State,
 			&cur.CreatedAt,
 			&cur.Merged,
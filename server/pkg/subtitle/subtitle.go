@@ -0,0 +1,234 @@
+package subtitle
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/warmans/rsk-search/pkg/models"
+)
+
+const (
+	// maxLineChars is the max length of each line of a cue. 42 is the usual limit for broadcast subtitles.
+	maxLineChars = 42
+	// maxCueLines is the max number of lines shown at once. Longer dialog is split into multiple cues.
+	maxCueLines = 2
+	// maxCueDuration limits how long a cue is shown if the dialog has no duration.
+	maxCueDuration = 7 * time.Second
+	// minCueDuration is the shortest time a cue is shown for.
+	minCueDuration = time.Second
+)
+
+type cue struct {
+	id    string
+	start time.Duration
+	end   time.Duration
+	actor string
+	lines []string
+	// inferred is true if the start time was estimated rather than taken from the audio.
+	inferred bool
+	// note is shown before the cue in formats that support comments.
+	note string
+}
+
+// HasTimestamps checks whether any of the dialog has a timestamp. Subtitles are not useful without them.
+func HasTimestamps(dialog []models.Dialog) bool {
+	for _, d := range dialog {
+		if d.Timestamp > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteSRT writes the dialog as SubRip subtitles. Speakers are labelled in the text when they change. SRT has
+// no comments so gaps and inferred timestamps are not marked.
+func WriteSRT(w io.Writer, dialog []models.Dialog) error {
+	allCues, _ := cues(dialog, true)
+	for k, c := range allCues {
+		text := strings.Join(c.lines, "\n")
+		if _, err := fmt.Fprintf(w, "%d\n%s --> %s\n%s\n\n", k+1, formatTimestamp(c.start, ','), formatTimestamp(c.end, ','), text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteVTT writes the dialog as WebVTT subtitles. The speaker of each cue is given as a voice span. Gaps and
+// cues with inferred timestamps are preceded by a NOTE.
+func WriteVTT(w io.Writer, dialog []models.Dialog) error {
+	if _, err := fmt.Fprint(w, "WEBVTT\n\n"); err != nil {
+		return err
+	}
+	allCues, trailingNote := cues(dialog, false)
+	for _, c := range allCues {
+		notes := []string{}
+		if c.note != "" {
+			notes = append(notes, c.note)
+		}
+		if c.inferred {
+			notes = append(notes, "timing of the following cue is approximate")
+		}
+		for _, note := range notes {
+			if _, err := fmt.Fprintf(w, "NOTE %s\n\n", note); err != nil {
+				return err
+			}
+		}
+		text := vttEscape(strings.Join(c.lines, "\n"))
+		if c.actor != "" {
+			text = fmt.Sprintf("<v %s>%s</v>", vttEscape(c.actor), text)
+		}
+		if _, err := fmt.Fprintf(w, "%s\n%s --> %s\n%s\n\n", c.id, formatTimestamp(c.start, '.'), formatTimestamp(c.end, '.'), text); err != nil {
+			return err
+		}
+	}
+	if trailingNote != "" {
+		if _, err := fmt.Fprintf(w, "NOTE %s\n\n", trailingNote); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cues splits the dialog into cues. If labelSpeakers is true the text is prefixed with the speaker's name when
+// it changes, for formats with no other way to give the speaker. Any note after the last cue is returned
+// separately.
+func cues(dialog []models.Dialog, labelSpeakers bool) ([]cue, string) {
+	out := []cue{}
+	note := ""
+	prevActor := ""
+	for k, d := range dialog {
+		if d.Placeholder {
+			continue
+		}
+		if d.Type == models.DialogTypeGap {
+			note = fmt.Sprintf("GAP: %s of audio has no transcript", d.Duration.Round(time.Second))
+			prevActor = ""
+			continue
+		}
+		content := strings.TrimSpace(d.Content)
+		if content == "" {
+			continue
+		}
+
+		text := content
+		actor := d.Actor
+		if d.Type == models.DialogTypeSong {
+			text = fmt.Sprintf("♪ %s ♪", content)
+			actor = ""
+		} else if labelSpeakers && actor != "" && actor != prevActor {
+			// speakers are only labelled when they change.
+			text = fmt.Sprintf("%s: %s", strings.ToUpper(actor), content)
+		}
+		prevActor = actor
+
+		start, end := cueTiming(dialog, k)
+		chunks := splitLines(wrap(text))
+		totalChars := 0
+		for _, chunk := range chunks {
+			totalChars += chunkLength(chunk)
+		}
+		// the time is shared between the chunks in proportion to the amount of text in each.
+		chunkStart := start
+		for i, chunk := range chunks {
+			chunkEnd := end
+			if i < len(chunks)-1 {
+				chunkEnd = chunkStart + time.Duration(float64(end-start)*float64(chunkLength(chunk))/float64(totalChars))
+			}
+			c := cue{
+				id:       d.ID,
+				start:    chunkStart,
+				end:      chunkEnd,
+				actor:    actor,
+				lines:    chunk,
+				inferred: d.TimestampInferred,
+			}
+			if len(chunks) > 1 {
+				c.id = fmt.Sprintf("%s-%d", d.ID, i+1)
+			}
+			if i == 0 {
+				c.note = note
+				note = ""
+			}
+			out = append(out, c)
+			chunkStart = chunkEnd
+		}
+	}
+	return out, note
+}
+
+// cueTiming returns the start and end of the dialog. If the dialog has no duration it is shown until the next
+// line starts, up to the max cue duration. Cues are shown for at least the min duration unless the next line
+// starts sooner.
+func cueTiming(dialog []models.Dialog, idx int) (time.Duration, time.Duration) {
+	start := dialog[idx].Timestamp
+	var next time.Duration
+	for _, d := range dialog[idx+1:] {
+		if d.Timestamp > start {
+			next = d.Timestamp
+			break
+		}
+	}
+	end := start + maxCueDuration
+	if dialog[idx].Duration > 0 {
+		end = start + max(dialog[idx].Duration, minCueDuration)
+	}
+	// cues should not overlap as not all players can show them at the same time.
+	if next > 0 && next < end {
+		end = next
+	}
+	return start, end
+}
+
+// wrap splits the text into lines no longer than maxLineChars unless a single word is longer.
+func wrap(text string) []string {
+	lines := []string{}
+	current := ""
+	for _, word := range strings.Fields(text) {
+		if current != "" && len([]rune(current))+1+len([]rune(word)) > maxLineChars {
+			lines = append(lines, current)
+			current = ""
+		}
+		if current != "" {
+			current += " "
+		}
+		current += word
+	}
+	if current != "" {
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+// splitLines groups the lines into chunks that fit in a single cue.
+func splitLines(lines []string) [][]string {
+	chunks := [][]string{}
+	for i := 0; i < len(lines); i += maxCueLines {
+		chunks = append(chunks, lines[i:min(len(lines), i+maxCueLines)])
+	}
+	return chunks
+}
+
+func chunkLength(chunk []string) int {
+	total := 0
+	for _, line := range chunk {
+		total += len([]rune(line))
+	}
+	return total
+}
+
+func formatTimestamp(d time.Duration, msSeparator rune) string {
+	return fmt.Sprintf(
+		"%02d:%02d:%02d%c%03d",
+		int(d.Hours()),
+		int(d.Minutes())%60,
+		int(d.Seconds())%60,
+		msSeparator,
+		d.Milliseconds()%1000,
+	)
+}
+
+func vttEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
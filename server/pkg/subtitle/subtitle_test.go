@@ -0,0 +1,91 @@
+package subtitle
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warmans/rsk-search/pkg/models"
+)
+
+func testDialog() []models.Dialog {
+	return []models.Dialog{
+		{ID: "ep-xfm-S1E01-1", Type: models.DialogTypeSong, Timestamp: time.Second, Content: "Jimmy Webb - Galveston"},
+		{ID: "ep-xfm-S1E01-2", Type: models.DialogTypeChat, Timestamp: 10 * time.Second, Duration: 2 * time.Second, Actor: "ricky", Content: "Hello."},
+		{ID: "ep-xfm-S1E01-3", Type: models.DialogTypeChat, Timestamp: 12 * time.Second, Duration: 2 * time.Second, Actor: "ricky", Content: "Karl?"},
+		{ID: "ep-xfm-S1E01-4", Type: models.DialogTypeGap, Timestamp: 14 * time.Second, Duration: 10 * time.Minute},
+		{ID: "ep-xfm-S1E01-5", Type: models.DialogTypeChat, Timestamp: 10*time.Minute + 14*time.Second, TimestampInferred: true, Actor: "karl", Content: "I was reading about a monkey that went into space & came back with all sorts of problems."},
+		{ID: "ep-xfm-S1E01-6", Type: models.DialogTypeChat, Timestamp: 10*time.Minute + 20*time.Second, Actor: "steve", Content: ""},
+		{ID: "ep-xfm-S1E01-7", Type: models.DialogTypeGap, Timestamp: 10*time.Minute + 30*time.Second, Duration: 5 * time.Minute},
+	}
+}
+
+func TestWriteSRT(t *testing.T) {
+	buff := &bytes.Buffer{}
+	require.NoError(t, WriteSRT(buff, testDialog()))
+	require.Equal(t, `1
+00:00:01,000 --> 00:00:08,000
+♪ Jimmy Webb - Galveston ♪
+
+2
+00:00:10,000 --> 00:00:12,000
+RICKY: Hello.
+
+3
+00:00:12,000 --> 00:00:14,000
+Karl?
+
+4
+00:10:14,000 --> 00:10:19,225
+KARL: I was reading about a monkey that
+went into space & came back with all sorts
+
+5
+00:10:19,225 --> 00:10:20,000
+of problems.
+
+`, buff.String())
+}
+
+func TestWriteVTT(t *testing.T) {
+	buff := &bytes.Buffer{}
+	require.NoError(t, WriteVTT(buff, testDialog()))
+	require.Equal(t, `WEBVTT
+
+ep-xfm-S1E01-1
+00:00:01.000 --> 00:00:08.000
+♪ Jimmy Webb - Galveston ♪
+
+ep-xfm-S1E01-2
+00:00:10.000 --> 00:00:12.000
+<v ricky>Hello.</v>
+
+ep-xfm-S1E01-3
+00:00:12.000 --> 00:00:14.000
+<v ricky>Karl?</v>
+
+NOTE GAP: 10m0s of audio has no transcript
+
+NOTE timing of the following cue is approximate
+
+ep-xfm-S1E01-5-1
+00:10:14.000 --> 00:10:19.379
+<v karl>I was reading about a monkey that went
+into space &amp; came back with all sorts of</v>
+
+NOTE timing of the following cue is approximate
+
+ep-xfm-S1E01-5-2
+00:10:19.379 --> 00:10:20.000
+<v karl>problems.</v>
+
+NOTE GAP: 5m0s of audio has no transcript
+
+`, buff.String())
+}
+
+func TestHasTimestamps(t *testing.T) {
+	require.True(t, HasTimestamps(testDialog()))
+	require.False(t, HasTimestamps([]models.Dialog{{Content: "foo"}}))
+}
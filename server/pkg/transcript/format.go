@@ -0,0 +1,39 @@
+package transcript
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+)
+
+// Format rewrites a raw transcript in the same form as Export e.g. with lowercase actors, consistent tag
+// spacing and offsets to two decimal places. The imported transcript is not changed by formatting.
+// Import ignores tags it cannot parse so they are reported as errors rather than being silently dropped.
+func Format(raw string) (string, error) {
+	if err := checkTags(raw); err != nil {
+		return "", err
+	}
+	ts, err := Import(bufio.NewScanner(strings.NewReader(raw)), "", 0)
+	if err != nil {
+		return "", err
+	}
+	return Export(ts.Transcript, ts.Synopsis, ts.Trivia)
+}
+
+// checkTags returns an error for the first offset or gap tag that cannot be parsed.
+func checkTags(raw string) error {
+	for num, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(strings.ReplaceAll(line, "\u00a0", " "))
+		switch {
+		case IsOffsetTag(line):
+			if _, ok := ScanSeconds(OffsetTag, line); !ok {
+				return fmt.Errorf("line %d: invalid offset: %s", num+1, line)
+			}
+		case IsGapTag(line):
+			if _, err := ScanDuration(GapTag, line); err != nil {
+				return fmt.Errorf("line %d: invalid gap: %s", num+1, line)
+			}
+		}
+	}
+	return nil
+}
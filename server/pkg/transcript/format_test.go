@@ -0,0 +1,90 @@
+package transcript
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "whitespace and actor casing",
+			raw:  "  Ricky:   foo\n\n\nKARL:bar  \n steve : baz\n",
+			want: "ricky: Foo\nkarl: Bar\nsteve: Baz\n",
+		},
+		{
+			name: "missing and special actors",
+			raw:  "just some text\nNone: foo\nSONG: la la la\n!ricky: notable\n",
+			want: "none: Just some text\nnone: Foo\nsong: La la la\n!ricky: Notable\n",
+		},
+		{
+			name: "offsets",
+			raw:  "#OFFSET: 1\nricky: foo\n#OFFSET:2.5\nkarl: bar\n#OFFSET: 150.07\nsteve: baz\n#OFFSET: 151.005\nricky: qux\n",
+			want: "#OFFSET: 1.00\nricky: Foo\n#OFFSET: 2.50\nkarl: Bar\n#OFFSET: 150.07\nsteve: Baz\n#OFFSET: 151.005\nricky: Qux\n",
+		},
+		{
+			name: "tags",
+			raw:  "#SYN:   intro\n#  more intro\n#\n# last line\n#OFFSET: 1\nricky: foo\n#TRIVIA: fact\nkarl: bar\n#/SYN\n#OFFSET: 10\n#GAP: 10m\nsteve: baz\n",
+			want: "#OFFSET: 1.00\n#SYN: Intro\n# more intro\n#\n# last line\nricky: Foo\n#TRIVIA: Fact\nkarl: Bar\n#OFFSET: 10.00\n#/SYN\n#GAP: 10m0s\nsteve: Baz\n",
+		},
+		{
+			name: "tags without spaces",
+			raw:  "#SYN:intro\nricky: foo\n#TRIVIA:fact\nkarl: bar\n#/TRIVIA\n#/SYN\nsteve: baz\n",
+			want: "#SYN: Intro\nricky: Foo\n#TRIVIA: Fact\nkarl: Bar\n#/SYN\n#/TRIVIA\nsteve: Baz\n",
+		},
+		{
+			name:    "unparseable offset",
+			raw:     "ricky: foo\n#OFFSET: nonsense\nkarl: bar\n",
+			wantErr: true,
+		},
+		{
+			name:    "unparseable gap",
+			raw:     "ricky: foo\n#GAP: ages\nkarl: bar\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid offsets",
+			raw:     "#OFFSET: 10\n#OFFSET: 5\nricky: foo\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Format(tt.raw)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.EqualValues(t, tt.want, got)
+
+			// no text may be dropped, Import would not notice as it drops the same text from both.
+			for _, line := range strings.Split(tt.raw, "\n") {
+				for _, word := range strings.FieldsFunc(strings.ToLower(line), func(r rune) bool { return !unicode.IsLetter(r) }) {
+					require.Contains(t, strings.ToLower(got), word, "line: %s", line)
+				}
+			}
+
+			// formatting must not change the meaning of the transcript.
+			before, err := Import(bufio.NewScanner(strings.NewReader(tt.raw)), "ep-test", 0)
+			require.NoError(t, err)
+			after, err := Import(bufio.NewScanner(strings.NewReader(got)), "ep-test", 0)
+			require.NoError(t, err)
+			require.EqualValues(t, before, after)
+
+			// and formatted transcripts should not change.
+			again, err := Format(got)
+			require.NoError(t, err)
+			require.EqualValues(t, got, again)
+		})
+	}
+}
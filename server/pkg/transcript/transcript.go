@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"github.com/pkg/errors"
 	"github.com/warmans/rsk-search/pkg/models"
+	"math"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -200,7 +202,7 @@ func Import(scanner *bufio.Scanner, episodeID string, startPos int64) (*models.T
 				transcript.Synopsis = append(transcript.Synopsis, *currentSynopsis)
 				currentSynopsis = nil
 			}
-			if strings.HasPrefix(line, "#SYN: ") {
+			if strings.HasPrefix(line, SynopsisTag.Open()) {
 				currentSynopsis = &models.Synopsis{Description: CorrectContent(strings.TrimSpace(strings.TrimPrefix(line, "#SYN:"))), StartPos: position}
 				nextLines, err := parser.ReadAllPrefixed("#")
 				if err != nil {
@@ -247,7 +249,7 @@ func Import(scanner *bufio.Scanner, episodeID string, startPos int64) (*models.T
 		// line should be in the format "actor: text..."
 		parts := strings.SplitN(line, ":", 2)
 		if len(parts) != 2 {
-			fmt.Printf("WARN: invalid line detected (missing actor): %s\n", line)
+			fmt.Fprintf(os.Stderr, "WARN: invalid line detected (missing actor): %s\n", line)
 			parts = []string{"none", line}
 		}
 
@@ -295,14 +297,8 @@ func Export(dialog []models.Dialog, synopsis []models.Synopsis, trivia []models.
 	output := strings.Builder{}
 	for _, d := range dialog {
 		if !options.stripMetadata {
-
-			if d.Type == models.DialogTypeGap {
-				output.WriteString(fmt.Sprintf("#GAP: %s\n", d.Duration.String()))
-				continue
-			}
-
 			if d.Timestamp > 0 && !d.TimestampInferred {
-				output.WriteString(fmt.Sprintf("#OFFSET: %0.2f\n", d.Timestamp.Seconds()))
+				output.WriteString(fmt.Sprintf("#OFFSET: %s\n", FormatSeconds(d.Timestamp)))
 			}
 			for _, syn := range synopsis {
 				if d.Position == syn.StartPos {
@@ -310,7 +306,7 @@ func Export(dialog []models.Dialog, synopsis []models.Synopsis, trivia []models.
 					output.WriteString(fmt.Sprintf("#SYN: %s\n", synopsisLines[0]))
 					if len(synopsisLines) > 1 {
 						for _, line := range synopsisLines[1:] {
-							output.WriteString(fmt.Sprintf("# %s\n", strings.TrimSpace(line)))
+							output.WriteString(strings.TrimSpace(fmt.Sprintf("# %s", strings.TrimSpace(line))) + "\n")
 						}
 					}
 				}
@@ -324,7 +320,7 @@ func Export(dialog []models.Dialog, synopsis []models.Synopsis, trivia []models.
 					output.WriteString(fmt.Sprintf("#TRIVIA: %s\n", triviaLines[0]))
 					if len(triviaLines) > 1 {
 						for _, line := range triviaLines[1:] {
-							output.WriteString(fmt.Sprintf("# %s\n", strings.TrimSpace(line)))
+							output.WriteString(strings.TrimSpace(fmt.Sprintf("# %s", strings.TrimSpace(line))) + "\n")
 						}
 					}
 				}
@@ -332,6 +328,11 @@ func Export(dialog []models.Dialog, synopsis []models.Synopsis, trivia []models.
 					output.WriteString("#/TRIVIA\n")
 				}
 			}
+			// gaps are written after the other tags so their offsets and ranges are not lost.
+			if d.Type == models.DialogTypeGap {
+				output.WriteString(fmt.Sprintf("#GAP: %s\n", d.Duration.String()))
+				continue
+			}
 		}
 
 		noteable := ""
@@ -362,7 +363,7 @@ func CorrectContent(c string) string {
 }
 
 func IsTag(line string) bool {
-	return IsOffsetTag(line) || IsTriviaTag(line) || IsSynopsisTag(line)
+	return IsOffsetTag(line) || IsGapTag(line) || IsTriviaTag(line) || IsSynopsisTag(line)
 }
 
 func IsOffsetTag(line string) bool {
@@ -384,11 +385,20 @@ func IsSynopsisTag(line string) bool {
 func ScanSeconds(tagPrefix tag, line string) (time.Duration, bool) {
 	offsetStr := strings.TrimSpace(strings.TrimPrefix(line, tagPrefix.Open()))
 	if off, err := strconv.ParseFloat(offsetStr, 64); err == nil {
-		return time.Duration(off*1000) * time.Millisecond, true
+		// round rather than truncate as e.g. 2.01 seconds is 2009.999... milliseconds.
+		return time.Duration(math.Round(off*1000)) * time.Millisecond, true
 	}
 	return 0, false
 }
 
+// FormatSeconds formats an offset as seconds. Two decimal places are used unless the offset is more precise.
+func FormatSeconds(d time.Duration) string {
+	if d%(10*time.Millisecond) != 0 {
+		return fmt.Sprintf("%0.3f", d.Seconds())
+	}
+	return fmt.Sprintf("%0.2f", d.Seconds())
+}
+
 func ScanDuration(tagPrefix tag, line string) (time.Duration, error) {
 	strDuration := strings.TrimSpace(strings.TrimPrefix(line, tagPrefix.Open()))
 	return time.ParseDuration(strDuration)
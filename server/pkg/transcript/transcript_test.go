@@ -181,6 +181,49 @@ karl: Bar
 #OFFSET: 3.00
 #/TRIVIA
 steve: Baz
+`,
+			wantErr: false,
+		}, {
+			name: "gaps keep their offsets and ranges",
+			args: args{
+				dialog: []models.Dialog{
+					{
+						Position:  1,
+						Timestamp: time.Second * 1,
+						Type:      models.DialogTypeChat,
+						Actor:     "ricky",
+						Content:   "Foo",
+					},
+					{
+						Position:  2,
+						Timestamp: time.Second * 2,
+						Type:      models.DialogTypeGap,
+						Duration:  time.Minute,
+					},
+					{
+						Position:  3,
+						Timestamp: time.Second * 62,
+						Type:      models.DialogTypeChat,
+						Actor:     "karl",
+						Content:   "Bar",
+					},
+				},
+				synopsis: []models.Synopsis{
+					{
+						Description: "Synopsis",
+						StartPos:    2,
+						EndPos:      3,
+					},
+				},
+			},
+			want: `#OFFSET: 1.00
+ricky: Foo
+#OFFSET: 2.00
+#SYN: Synopsis
+#GAP: 1m0s
+#OFFSET: 62.00
+#/SYN
+karl: Bar
 `,
 			wantErr: false,
 		}, {
@@ -244,6 +287,7 @@ steve: Baz
 			ts, err := Import(bufio.NewScanner(strings.NewReader(got)), "", 0)
 			require.NoError(t, err)
 			for k := range ts.Transcript {
+				require.EqualValues(t, tt.args.dialog[k].Type, ts.Transcript[k].Type)
 				require.EqualValues(t, tt.args.dialog[k].Timestamp, ts.Transcript[k].Timestamp)
 				require.EqualValues(t, tt.args.dialog[k].Content, ts.Transcript[k].Content)
 				require.EqualValues(t, tt.args.dialog[k].Actor, ts.Transcript[k].Actor)
@@ -310,3 +354,24 @@ Karl: 3
 	require.EqualValues(t, models.DialogTypeChat, ts.Transcript[3].Type)
 	require.EqualValues(t, "3", ts.Transcript[3].Content)
 }
+
+func TestScanSeconds(t *testing.T) {
+	tests := []struct {
+		line   string
+		want   time.Duration
+		wantOk bool
+	}{
+		{line: "#OFFSET: 1", want: time.Second, wantOk: true},
+		{line: "#OFFSET: 150.07", want: 150*time.Second + 70*time.Millisecond, wantOk: true},
+		{line: "#OFFSET: 2.01", want: 2*time.Second + 10*time.Millisecond, wantOk: true},
+		{line: "#OFFSET: 1.005", want: time.Second + 5*time.Millisecond, wantOk: true},
+		{line: "#OFFSET: nonsense", want: 0, wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			got, ok := ScanSeconds(OffsetTag, tt.line)
+			require.EqualValues(t, tt.wantOk, ok)
+			require.EqualValues(t, tt.want, got)
+		})
+	}
+}
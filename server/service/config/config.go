@@ -16,6 +16,9 @@ type SearchServiceConfig struct {
 	MediaBasePath         string
 	VideoPartialsBasePath string
 	ArchiveBasePath       string
+	ExplainEnabled        bool
+	SearchCacheSize       int64
+	SearchBackend         string
 }
 
 func (c *SearchServiceConfig) RegisterFlags(fs *pflag.FlagSet, prefix string) {
@@ -27,6 +30,9 @@ func (c *SearchServiceConfig) RegisterFlags(fs *pflag.FlagSet, prefix string) {
 	flag.StringVarEnv(fs, &c.MediaBasePath, prefix, "media-base-path", "/audio", "location of media files")
 	flag.StringVarEnv(fs, &c.VideoPartialsBasePath, prefix, "video-partials-base-path", "./var/video-partials", "partial video files used to generate gifs")
 	flag.StringVarEnv(fs, &c.ArchiveBasePath, prefix, "archive-base-path", "./var/archive", "archived files dir")
+	flag.BoolVarEnv(fs, &c.ExplainEnabled, prefix, "explain-enabled", false, "Allow all users to request search explanations (otherwise only approvers)")
+	flag.StringVarEnv(fs, &c.SearchBackend, prefix, "search-backend", "bluge", "Search implementation to use: bluge or sqlite (requires the sqlite_fts5 build tag)")
+	flag.Int64VarEnv(fs, &c.SearchCacheSize, prefix, "search-cache-size", 1000, "Max number of search results to cache (0 to disable)")
 	flag.BoolVarEnv(fs, &c.RewardsDisabled, prefix, "rewards-disabled", false, "Disable claiming rewards (but sill calculate them)")
 	flag.StringVarEnv(fs, &c.AudioUriPattern, prefix, "audio-uri-pattern", "/dl/media/episode/%s.mp3", "episode ID e.g. xfm-S1E01 will be interpolated into this string")
 }
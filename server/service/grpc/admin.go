@@ -8,6 +8,7 @@ import (
 	"github.com/warmans/rsk-search/gen/api"
 	"github.com/warmans/rsk-search/pkg/jwt"
 	"github.com/warmans/rsk-search/pkg/models"
+	"github.com/warmans/rsk-search/pkg/reload"
 	"github.com/warmans/rsk-search/pkg/store/rw"
 	"github.com/warmans/rsk-search/service/queue"
 	"go.uber.org/zap"
@@ -20,12 +21,14 @@ func NewAdminService(
 	taskQueue queue.ImportPipeline,
 	auth *jwt.Auth,
 	persistentDB *rw.Conn,
+	reloader *reload.Reloader,
 ) *AdminService {
 	return &AdminService{
 		logger:       logger,
 		taskQueue:    taskQueue,
 		auth:         auth,
 		persistentDB: persistentDB,
+		reloader:     reloader,
 	}
 }
 
@@ -34,6 +37,7 @@ type AdminService struct {
 	taskQueue    queue.ImportPipeline
 	auth         *jwt.Auth
 	persistentDB *rw.Conn
+	reloader     *reload.Reloader
 }
 
 func (s *AdminService) RegisterGRPC(server *grpc.Server) {
@@ -141,6 +145,20 @@ func (s *AdminService) ListTscriptImports(ctx context.Context, request *api.List
 	return &api.TscriptImportList{Imports: out}, nil
 }
 
+func (s *AdminService) ReloadData(ctx context.Context, empty *emptypb.Empty) (*emptypb.Empty, error) {
+	claims, err := s.getClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !claims.Approver {
+		return nil, ErrUnauthorized("Only approvers may reload data")
+	}
+	if err := s.reloader.Reload(ctx); err != nil {
+		return nil, ErrInternal(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *AdminService) getClaims(ctx context.Context) (*jwt.Claims, error) {
 	token := jwt.ExtractTokenFromRequestContext(ctx)
 	if token == "" {
@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/karlseguin/ccache/v2"
+	"github.com/pkg/errors"
 	"github.com/warmans/rsk-search/gen/api"
 	"github.com/warmans/rsk-search/pkg/data"
 	"github.com/warmans/rsk-search/pkg/filter"
+	"github.com/warmans/rsk-search/pkg/frequency"
 	"github.com/warmans/rsk-search/pkg/jwt"
 	"github.com/warmans/rsk-search/pkg/meta"
 	"github.com/warmans/rsk-search/pkg/roadmap"
@@ -23,6 +27,25 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+const (
+	defaultSequenceWindow = 3
+	maxSequenceWindow     = 20
+	maxSequenceSteps      = 5
+	maxPageSize           = 100
+	maxContextLines       = 20
+	maxFrequencyTerms     = 5
+	maxFrequencyTermWords = 5
+	maxPhraseWords        = 5
+	defaultPhraseMinCount = 5
+	// minCorpusPhraseMinCount stops phrases said once or twice being counted over every episode.
+	minCorpusPhraseMinCount = 3
+	defaultPhraseResults    = 50
+	maxPhraseResults        = 500
+	phraseCacheSize         = 1000
+	// phraseCacheTTL is a backstop, cached phrases are normally invalidated by the episodes being reloaded.
+	phraseCacheTTL = time.Hour * 24
+)
+
 func NewSearchService(
 	logger *zap.Logger,
 	srvCfg config.SearchServiceConfig,
@@ -40,6 +63,7 @@ func NewSearchService(
 		persistentDB:  persistentDB,
 		auth:          auth,
 		episodeCache:  episodeCache,
+		phraseCache:   ccache.New(ccache.Configure().MaxSize(phraseCacheSize).ItemsToPrune(phraseCacheSize / 10)),
 	}
 }
 
@@ -51,6 +75,7 @@ type SearchService struct {
 	persistentDB  *rw.Conn
 	auth          *jwt.Auth
 	episodeCache  *data.EpisodeCache
+	phraseCache   *ccache.Cache
 }
 
 func (s *SearchService) RegisterGRPC(server *grpc.Server) {
@@ -90,11 +115,194 @@ func (s *SearchService) Search(ctx context.Context, request *api.SearchRequest)
 		}
 	}
 
+	for _, facet := range request.Facets {
+		if !slices.Contains(search.Facets, facet) {
+			return nil, ErrInvalidRequestField("facets", nil, fmt.Sprintf("unknown facet: %s", facet))
+		}
+	}
+
+	if request.PageSize < 0 || request.PageSize > maxPageSize {
+		return nil, ErrInvalidRequestField("page_size", nil, fmt.Sprintf("must be between 1 and %d", maxPageSize))
+	}
+
+	if request.ContextLines < 0 || request.ContextLines > maxContextLines {
+		return nil, ErrInvalidRequestField("context_lines", nil, fmt.Sprintf("must be between 0 and %d", maxContextLines))
+	}
+
 	if err := checkWhy(f); err != nil {
 		return nil, err
 	}
 
-	return s.searchBackend.Search(ctx, f, request.Page, request.Sort)
+	if request.Explain && !s.srvCfg.ExplainEnabled && !IsApprover(ctx, s.auth) {
+		return nil, ErrPermissionDenied("explain is only available to approvers")
+	}
+
+	if len(request.Sequence) > 0 {
+		if request.SearchAfter != "" {
+			return nil, ErrInvalidRequestField("search_after", nil, "cannot be used with a sequence search")
+		}
+		if request.Explain {
+			return nil, ErrInvalidRequestField("explain", nil, "cannot be used with a sequence search")
+		}
+		return s.searchSequence(ctx, f, request)
+	}
+
+	res, err := s.searchBackend.Search(ctx, search.Request{
+		Filter:         f,
+		Sort:           request.Sort,
+		Facets:         request.Facets,
+		Page:           request.Page,
+		PageSize:       request.PageSize,
+		SearchAfter:    request.SearchAfter,
+		Explain:        request.Explain,
+		ContextLines:   request.ContextLines,
+		DisableAliases: request.DisableAliases,
+	})
+	if err != nil {
+		if errors.Is(err, search.ErrInvalidCursor) {
+			return nil, ErrInvalidRequestField("search_after", err)
+		}
+		if errors.Is(err, search.ErrUnsupported) {
+			return nil, ErrFailedPrecondition(err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *SearchService) searchSequence(ctx context.Context, f filter.Filter, request *api.SearchRequest) (*api.SearchResultList, error) {
+	if len(request.Sequence) > maxSequenceSteps {
+		return nil, ErrInvalidRequestField("sequence", nil, fmt.Sprintf("max %d steps allowed", maxSequenceSteps))
+	}
+	window := request.SequenceWindow
+	if window == 0 {
+		window = defaultSequenceWindow
+	}
+	if window < 1 || window > maxSequenceWindow {
+		return nil, ErrInvalidRequestField("sequence_window", nil, fmt.Sprintf("must be between 1 and %d", maxSequenceWindow))
+	}
+	steps := make([]filter.Filter, len(request.Sequence))
+	for k, v := range request.Sequence {
+		step, err := filter.Parse(v)
+		if err != nil {
+			return nil, ErrInvalidRequestField("sequence", err, fmt.Sprintf("sequence: %s", v))
+		}
+		if step == nil {
+			return nil, ErrInvalidRequestField("sequence", nil, "steps cannot be empty")
+		}
+		if err := checkWhy(step); err != nil {
+			return nil, err
+		}
+		steps[k] = step
+	}
+	res, err := s.searchBackend.SearchSequence(ctx, f, steps, window, request.Page, request.PageSize, request.Sort, request.ContextLines)
+	if err != nil {
+		if errors.Is(err, search.ErrSequenceTooBroad) {
+			return nil, ErrInvalidRequestField("sequence", err)
+		}
+		if errors.Is(err, search.ErrUnsupported) {
+			return nil, ErrFailedPrecondition(err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *SearchService) SearchSimilar(ctx context.Context, request *api.SearchSimilarRequest) (*api.SearchResultList, error) {
+	if strings.TrimSpace(request.DialogId) == "" {
+		return nil, ErrInvalidRequestField("dialog_id", nil, "cannot be empty")
+	}
+	if request.MaxResults < 0 || request.MaxResults > maxPageSize {
+		return nil, ErrInvalidRequestField("max_results", nil, fmt.Sprintf("must be between 1 and %d", maxPageSize))
+	}
+	res, err := s.searchBackend.SearchSimilar(ctx, request.DialogId, request.MaxResults)
+	if err != nil {
+		if errors.Is(err, search.ErrNotFound) {
+			return nil, ErrNotFound(request.DialogId)
+		}
+		if errors.Is(err, search.ErrUnsupported) {
+			return nil, ErrFailedPrecondition(err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *SearchService) GetTermFrequency(ctx context.Context, request *api.GetTermFrequencyRequest) (*api.TermFrequency, error) {
+	if len(request.Terms) == 0 || len(request.Terms) > maxFrequencyTerms {
+		return nil, ErrInvalidRequestField("terms", nil, fmt.Sprintf("between 1 and %d terms must be given", maxFrequencyTerms))
+	}
+	for _, term := range request.Terms {
+		if words := frequency.Words(term); len(words) == 0 || len(words) > maxFrequencyTermWords {
+			return nil, ErrInvalidRequestField("terms", nil, fmt.Sprintf("terms must have between 1 and %d words: %s", maxFrequencyTermWords, term))
+		}
+	}
+	res, err := frequency.TermFrequency(s.episodeCache, meta.EpisodeList(), request.Terms, request.ByActor)
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+	return res, nil
+}
+
+func (s *SearchService) ListPhrases(ctx context.Context, request *api.ListPhrasesRequest) (*api.PhraseList, error) {
+	opts := frequency.PhraseOptions{
+		MinWords:   2,
+		MaxWords:   maxPhraseWords,
+		MinCount:   defaultPhraseMinCount,
+		MaxResults: defaultPhraseResults,
+		Scoring:    request.Scoring,
+	}
+	if request.MinWords != 0 {
+		opts.MinWords = int(request.MinWords)
+	}
+	if request.MaxWords != 0 {
+		opts.MaxWords = int(request.MaxWords)
+	}
+	if opts.MinWords < 2 || opts.MaxWords > maxPhraseWords || opts.MinWords > opts.MaxWords {
+		return nil, ErrInvalidRequestField("min_words", nil, fmt.Sprintf("phrases must be between 2 and %d words", maxPhraseWords))
+	}
+	if request.MinCount < 0 {
+		return nil, ErrInvalidRequestField("min_count", nil, "cannot be negative")
+	}
+	if request.MinCount > 0 {
+		opts.MinCount = request.MinCount
+	}
+	if request.EpisodeId == "" && opts.MinCount < minCorpusPhraseMinCount {
+		return nil, ErrInvalidRequestField("min_count", nil, fmt.Sprintf("must be at least %d unless episode_id is set", minCorpusPhraseMinCount))
+	}
+	if request.MaxResults < 0 || request.MaxResults > maxPhraseResults {
+		return nil, ErrInvalidRequestField("max_results", nil, fmt.Sprintf("must be between 1 and %d", maxPhraseResults))
+	}
+	if request.MaxResults > 0 {
+		opts.MaxResults = int(request.MaxResults)
+	}
+	if request.Series != 0 && request.Publication == "" {
+		return nil, ErrInvalidRequestField("series", nil, "publication must also be set")
+	}
+
+	episodeIDs := meta.EpisodeList()
+	if request.EpisodeId != "" {
+		if !slices.Contains(episodeIDs, request.EpisodeId) {
+			return nil, ErrNotFound(request.EpisodeId)
+		}
+		episodeIDs = []string{request.EpisodeId}
+	}
+
+	scope := frequency.Scope{Actor: request.Actor, Publication: request.Publication, Series: request.Series}
+
+	// the generation is part of the key so phrases from episodes that have since been reloaded are never returned.
+	key := fmt.Sprintf("%d:%q:%+v:%+v", s.episodeCache.Generation(), request.EpisodeId, scope, opts)
+	item, err := s.phraseCache.Fetch(key, phraseCacheTTL, func() (interface{}, error) {
+		phrases, err := frequency.Phrases(s.episodeCache, episodeIDs, scope, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &api.PhraseList{Phrases: phrases}, nil
+	})
+	if err != nil {
+		return nil, ErrInternal(err)
+	}
+	return item.Value().(*api.PhraseList), nil
 }
 
 func (s *SearchService) PredictSearchTerm(ctx context.Context, request *api.PredictSearchTermRequest) (*api.SearchTermPredictions, error) {
@@ -115,7 +323,14 @@ func (s *SearchService) PredictSearchTerm(ctx context.Context, request *api.Pred
 	if strings.TrimSpace(request.Prefix) == "" && f == nil {
 		return &api.SearchTermPredictions{}, nil
 	}
-	return s.searchBackend.PredictSearchTerms(ctx, request.Prefix, request.Exact, maxPredictions, f)
+	res, err := s.searchBackend.PredictSearchTerms(ctx, request.Prefix, request.Exact, maxPredictions, f)
+	if err != nil {
+		if errors.Is(err, search.ErrUnsupported) {
+			return nil, ErrFailedPrecondition(err.Error())
+		}
+		return nil, err
+	}
+	return res, nil
 }
 
 func (s *SearchService) ListChangelogs(ctx context.Context, request *api.ListChangelogsRequest) (*api.ChangelogList, error) {
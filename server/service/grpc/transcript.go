@@ -5,6 +5,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -15,8 +17,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/warmans/rsk-search/gen/api"
 	"github.com/warmans/rsk-search/pkg/data"
+	"github.com/warmans/rsk-search/pkg/diff"
 	"github.com/warmans/rsk-search/pkg/filter"
 	"github.com/warmans/rsk-search/pkg/jwt"
+	"github.com/warmans/rsk-search/pkg/lint"
 	"github.com/warmans/rsk-search/pkg/models"
 	"github.com/warmans/rsk-search/pkg/store/common"
 	"github.com/warmans/rsk-search/pkg/store/ro"
@@ -515,7 +519,7 @@ func (s *TranscriptService) GetTranscriptChange(ctx context.Context, request *ap
 		return nil, err
 	}
 
-	return change.Proto(), nil
+	return transcriptChangeProto(change), nil
 }
 
 func (s *TranscriptService) GetTranscriptChangeDiff(ctx context.Context, request *api.GetTranscriptChangeDiffRequest) (*api.TranscriptChangeDiff, error) {
@@ -601,11 +605,18 @@ func (s *TranscriptService) GetTranscriptChangeDiff(ctx context.Context, request
 		diffs = append(diffs, releaseDateDiff)
 	}
 
+	// the new transcript is formatted the same as the exported transcript so that only meaningful changes
+	// are shown. If it cannot be formatted it is compared as-is.
+	newTranscriptRaw, err := transcript.Format(newTranscript.Transcription)
+	if err != nil {
+		newTranscriptRaw = newTranscript.Transcription
+	}
+
 	// transcript diff
 	transcriptEdits := myers.ComputeEdits(
 		span.URIFromPath("TRANSCRIPT"),
 		oldTranscriptRaw,
-		newTranscript.Transcription,
+		newTranscriptRaw,
 	)
 	if len(transcriptEdits) > 0 {
 		transcriptDiff := fmt.Sprint(
@@ -619,7 +630,17 @@ func (s *TranscriptService) GetTranscriptChangeDiff(ctx context.Context, request
 		diffs = append(diffs, transcriptDiff)
 	}
 
-	return &api.TranscriptChangeDiff{Diffs: diffs}, nil
+	res := &api.TranscriptChangeDiff{Diffs: diffs}
+
+	// the structured diff needs a valid transcript which pending changes may not have yet.
+	newTranscriptParsed, err := transcript.Import(bufio.NewScanner(bytes.NewBufferString(newTranscript.Transcription)), oldTranscript.ID(), 1)
+	if err == nil {
+		structured := diff.Transcripts(oldTranscript, newTranscriptParsed)
+		res.DialogDiffs = structured.DialogDiffs
+		res.SynopsisDiffs = structured.SynopsisDiffs
+		res.TriviaDiffs = structured.TriviaDiffs
+	}
+	return res, nil
 }
 
 func (s *TranscriptService) CreateTranscriptChange(ctx context.Context, request *api.CreateTranscriptChangeRequest) (*api.TranscriptChange, error) {
@@ -646,6 +667,11 @@ func (s *TranscriptService) CreateTranscriptChange(ctx context.Context, request
 		}
 	}
 
+	baseTranscription, err := s.baseTranscription(request.Epid, request.TranscriptVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	var change *models.TranscriptChange
 	err = s.persistentDB.WithStore(func(s *rw.Store) error {
 
@@ -676,6 +702,7 @@ func (s *TranscriptService) CreateTranscriptChange(ctx context.Context, request
 			Name:              request.Name,
 			ReleaseDate:       releaseDate,
 			Transcription:     request.Transcript,
+			BaseTranscription: baseTranscription,
 			TranscriptVersion: request.TranscriptVersion,
 		})
 		return err
@@ -683,7 +710,24 @@ func (s *TranscriptService) CreateTranscriptChange(ctx context.Context, request
 	if err != nil {
 		return nil, ErrFromStore(err, "")
 	}
-	return change.Proto(), nil
+	return transcriptChangeProto(change), nil
+}
+
+// baseTranscription is the transcript a new change was based on. It is only known if the change was created from
+// the current version of the episode.
+func (s *TranscriptService) baseTranscription(epID string, version string) (string, error) {
+	ep, err := s.episodeCache.GetEpisode(epID, false)
+	if err != nil {
+		return "", ErrNotFound(epID)
+	}
+	if version != ep.Version && !(version == "NONE" && ep.Version == "") {
+		return "", nil
+	}
+	raw, err := transcript.Export(ep.Transcript, ep.Synopsis, ep.Trivia)
+	if err != nil {
+		return "", ErrInternal(err)
+	}
+	return raw, nil
 }
 
 func (s *TranscriptService) UpdateTranscriptChange(ctx context.Context, request *api.UpdateTranscriptChangeRequest) (*api.TranscriptChange, error) {
@@ -758,7 +802,15 @@ func (s *TranscriptService) UpdateTranscriptChange(ctx context.Context, request
 	if err != nil {
 		return nil, ErrFromStore(err, "")
 	}
-	return updatedChange.Proto(), nil
+	return transcriptChangeProto(updatedChange), nil
+}
+
+// transcriptChangeProto includes lint warnings with the change so they can be shown in the editor and checked
+// before the change is approved.
+func transcriptChangeProto(change *models.TranscriptChange) *api.TranscriptChange {
+	res := change.Proto()
+	res.LintWarnings = lint.Lint(change.Transcription, lint.DefaultRules()...).Proto()
+	return res
 }
 
 func (s *TranscriptService) DeleteTranscriptChange(ctx context.Context, request *api.DeleteTranscriptChangeRequest) (*emptypb.Empty, error) {
@@ -812,6 +864,11 @@ func (s *TranscriptService) RequestTranscriptChangeState(ctx context.Context, re
 	if err := s.validateContributionStateUpdate(claims, oldChange.Author.ID, oldChange.State, request.State); err != nil {
 		return nil, err
 	}
+	if request.State == api.ContributionState_STATE_APPROVED {
+		if err := s.validateTranscriptChangeMerge(ctx, oldChange); err != nil {
+			return nil, err
+		}
+	}
 	err = s.persistentDB.WithStore(func(tx *rw.Store) error {
 		if err := s.createAuthorNotification(ctx, tx, oldChange.Author.ID, request.State, "transcript change", ""); err != nil {
 			return err
@@ -824,6 +881,61 @@ func (s *TranscriptService) RequestTranscriptChangeState(ctx context.Context, re
 	return &emptypb.Empty{}, nil
 }
 
+// changes are merged in the order they were created, so an approved change must merge cleanly with the current
+// episode and any other changes that were already approved.
+func (s *TranscriptService) validateTranscriptChangeMerge(ctx context.Context, change *models.TranscriptChange) error {
+	var approved []*models.TranscriptChange
+	err := s.persistentDB.WithStore(func(s *rw.Store) error {
+		var err error
+		approved, err = s.ListTranscriptChanges(
+			ctx,
+			common.Q(
+				common.WithFilter(
+					filter.And(
+						filter.Eq("epid", filter.String(change.EpID)),
+						filter.Eq("merged", filter.Bool(false)),
+						filter.Eq("state", filter.String(string(models.ContributionStateApproved))),
+						filter.Neq("id", filter.String(change.ID)),
+					),
+				),
+				common.WithSorting("created_at", common.SortAsc),
+			),
+		)
+		return err
+	})
+	if err != nil {
+		return ErrFromStore(err, change.EpID)
+	}
+	current, err := s.episodeCache.GetEpisode(change.EpID, true)
+	if err != nil {
+		return ErrNotFound(change.EpID)
+	}
+	// changes are merged in the order they were created so the change being approved may not be last.
+	changes := append(approved, change)
+	slices.SortStableFunc(changes, func(a, b *models.TranscriptChange) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	for _, v := range changes {
+		merged, conflicts, err := diff.MergeChange(current, v)
+		if err != nil {
+			return ErrInvalidRequestField("transcript", err, fmt.Sprintf("failed to merge change %s", v.ID))
+		}
+		if len(conflicts) > 0 {
+			reasons := make([]string, len(conflicts))
+			for k, c := range conflicts {
+				reasons[k] = c.String()
+			}
+			return ErrFailedPrecondition(fmt.Sprintf("change %s conflicts with the current transcript: %s", v.ID, strings.Join(reasons, "; ")))
+		}
+		current.Transcript, current.Synopsis, current.Trivia = merged.Transcript, merged.Synopsis, merged.Trivia
+		// the version changes when the change is merged.
+		if current.Version, err = util.NextVersion(current.Version, util.PatchVersion); err != nil {
+			return ErrInternal(err)
+		}
+	}
+	return nil
+}
+
 // if an episode is currently being transcribed, mark it as locked to prevent changes being submitted before
 // all chunks have been completed.
 func (s *TranscriptService) lockedEpisodeIDs(ctx context.Context) (map[string]struct{}, error) {
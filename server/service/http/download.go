@@ -1,6 +1,7 @@
 package http
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"github.com/gorilla/handlers"
@@ -14,6 +15,7 @@ import (
 	"github.com/warmans/rsk-search/pkg/models"
 	"github.com/warmans/rsk-search/pkg/quota"
 	"github.com/warmans/rsk-search/pkg/store/rw"
+	"github.com/warmans/rsk-search/pkg/subtitle"
 	"github.com/warmans/rsk-search/pkg/util"
 	"github.com/warmans/rsk-search/service/config"
 	"github.com/warmans/rsk-search/service/metrics"
@@ -128,6 +130,7 @@ func (c *DownloadService) RegisterHTTP(ctx context.Context, router *mux.Router)
 
 	router.Path("/dl/episode/{episode}.json").Handler(handlers.RecoveryHandler()(http.HandlerFunc(c.DownloadEpisodeJSON)))
 	router.Path("/dl/episode/{episode}.txt").Handler(handlers.RecoveryHandler()(http.HandlerFunc(c.DownloadEpisodePlaintext)))
+	router.Path("/dl/episode/{episode}.{format:srt|vtt}").Handler(handlers.RecoveryHandler()(http.HandlerFunc(c.DownloadEpisodeSubtitles)))
 
 	router.Path("/dl/media/sprite/{episode_id}.jpg").Handler(handlers.RecoveryHandler()(http.HandlerFunc(c.DownloadVideoSprite)))
 	router.Path("/dl/media/file/{name}").Handler(handlers.RecoveryHandler()(http.HandlerFunc(c.DownloadFile)))
@@ -688,6 +691,55 @@ func (c *DownloadService) DownloadEpisodePlaintext(resp http.ResponseWriter, req
 	http.ServeFile(resp, req, path.Join(c.serviceConfig.FilesBasePath, "gen", "plaintext", fileName))
 }
 
+func (c *DownloadService) DownloadEpisodeSubtitles(resp http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	episode, ok := vars["episode"]
+	if !ok {
+		http.Error(resp, "No episode identifier given", http.StatusBadRequest)
+		return
+	}
+	if !meta.IsValidEpisodeID(episode) {
+		http.Error(resp, "Episode not found", http.StatusNotFound)
+		return
+	}
+	ep, err := c.episodeCache.GetEpisode(episode, false)
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			http.Error(resp, "Episode not found", http.StatusNotFound)
+			return
+		}
+		c.logger.Error("Failed to fetch episode", zap.String("episode", episode), zap.Error(err))
+		http.Error(resp, "Failed to fetch episode", http.StatusInternalServerError)
+		return
+	}
+	if !subtitle.HasTimestamps(ep.Transcript) {
+		http.Error(resp, "This episode has no timestamps", http.StatusNotFound)
+		return
+	}
+
+	buff := &bytes.Buffer{}
+	switch vars["format"] {
+	case "srt":
+		resp.Header().Set("Content-Type", "application/x-subrip")
+		err = subtitle.WriteSRT(buff, ep.Transcript)
+	case "vtt":
+		resp.Header().Set("Content-Type", "text/vtt")
+		err = subtitle.WriteVTT(buff, ep.Transcript)
+	default:
+		http.Error(resp, "Unknown format", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		c.logger.Error("Failed to write subtitles", zap.String("episode", episode), zap.Error(err))
+		http.Error(resp, "Failed to write subtitles", http.StatusInternalServerError)
+		return
+	}
+	resp.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.%s", episode, vars["format"]))
+	if _, err := io.Copy(resp, buff); err != nil {
+		c.logger.Error("Failed to write response", zap.Error(err))
+	}
+}
+
 func (c *DownloadService) incrementQuotas(ctx context.Context, mediaType string, fileID string, fileBytes int64) error {
 	fileMib := quota.BytesAsMib(fileBytes)
 	if fileMib == 0 {